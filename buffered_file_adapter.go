@@ -0,0 +1,698 @@
+package go_list_like
+
+import (
+	"io"
+	"os"
+)
+
+// The default number of bytes held by each page of a `BufferedFileAdapter`
+const DefaultFilePageSize = 4 * 1024
+
+// The default maximum number of pages a `BufferedFileAdapter` holds in memory
+const DefaultFilePageCount = 64
+
+// Adapts an `*os.File` into a `ListLike[byte, int]`, caching file data in memory
+// as fixed size pages so that repeated `Get()` and `Set()` calls do not each
+// perform a separate read or write on the file
+//
+// When a page must be loaded and the cache is full, the least recently used page
+// is evicted, writing it back to the file first if it was modified. Modified pages are
+// also written back by `Flush()`, `Sync()`, and `Close()`. Changes to the length of the
+// list (for example by `InsertSlotsAssumeCapacity()` or `DeleteRange()`) are applied to the
+// file immediately.
+//
+// The cache assumes it is the only writer to the file while the adapter is in use.
+// I/O errors are recorded and reported by `Err()` (see `ErrorReporter`)
+//
+// A `BufferedFileAdapter` must be created with `NewBufferedFileAdapter()` or
+// `NewBufferedFileAdapterWithPages()` and used through the returned pointer. It must not
+// be copied, as each copy would keep its own page list, length, and recorded error
+type BufferedFileAdapter struct {
+	file     *os.File
+	pageSize int64
+	maxPages int
+	size     int64
+	pages    map[int64]*filePage
+	newest   *filePage
+	oldest   *filePage
+	err      error
+}
+
+type filePage struct {
+	pageIdx int64
+	data    []byte
+	dirty   bool
+	newer   *filePage
+	older   *filePage
+}
+
+func NewBufferedFileAdapter(file *os.File) *BufferedFileAdapter {
+	return NewBufferedFileAdapterWithPages(file, DefaultFilePageSize, DefaultFilePageCount)
+}
+
+// Create a new `BufferedFileAdapter` that holds at most `maxPages` pages
+// of `pageSize` bytes each in memory
+//
+// If `pageSize <= 0` or `maxPages <= 0`, `DefaultFilePageSize` or `DefaultFilePageCount`
+// is used respectively
+func NewBufferedFileAdapterWithPages(file *os.File, pageSize int, maxPages int) *BufferedFileAdapter {
+	if pageSize <= 0 {
+		pageSize = DefaultFilePageSize
+	}
+	if maxPages <= 0 {
+		maxPages = DefaultFilePageCount
+	}
+	buf := &BufferedFileAdapter{
+		file:     file,
+		pageSize: int64(pageSize),
+		maxPages: maxPages,
+		pages:    make(map[int64]*filePage, maxPages),
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		buf.recordErr(err)
+	} else {
+		buf.size = stat.Size()
+	}
+	return buf
+}
+
+// SliceLike
+
+func (buf *BufferedFileAdapter) PreferLinearOps() bool {
+	return false
+}
+
+func (buf *BufferedFileAdapter) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (buf *BufferedFileAdapter) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (buf *BufferedFileAdapter) IdxValid(idx int) bool {
+	return idx >= 0 && int64(idx) < buf.size
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (buf *BufferedFileAdapter) RangeValid(firstIdx int, lastIdx int) bool {
+	return firstIdx >= 0 && firstIdx <= lastIdx && int64(lastIdx) < buf.size
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (buf *BufferedFileAdapter) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	return firstIdx + ((lastIdx - firstIdx) >> 1)
+}
+
+// Get the value at the provided index
+func (buf *BufferedFileAdapter) Get(idx int) (val byte) {
+	page, err := buf.getPage(int64(idx) / buf.pageSize)
+	if err != nil {
+		buf.recordErr(err)
+		return 0
+	}
+	return page.data[int64(idx)%buf.pageSize]
+}
+
+// Set the value at the provided index to the given value
+func (buf *BufferedFileAdapter) Set(idx int, val byte) {
+	page, err := buf.getPage(int64(idx) / buf.pageSize)
+	if err != nil {
+		buf.recordErr(err)
+		return
+	}
+	page.data[int64(idx)%buf.pageSize] = val
+	page.dirty = true
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (buf *BufferedFileAdapter) Move(oldIdx int, newIdx int) {
+	if newIdx < oldIdx {
+		buf.recordErr(rotate(buf, buf.pageSize, int64(newIdx), int64(oldIdx), int64(oldIdx)+1))
+	} else {
+		buf.recordErr(rotate(buf, buf.pageSize, int64(oldIdx), int64(oldIdx)+1, int64(newIdx)+1))
+	}
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (buf *BufferedFileAdapter) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	lenA := (lastIdx - firstIdx) + 1
+	if newFirstIdx < firstIdx {
+		buf.recordErr(rotate(buf, buf.pageSize, int64(newFirstIdx), int64(firstIdx), int64(lastIdx)+1))
+	} else {
+		buf.recordErr(rotate(buf, buf.pageSize, int64(firstIdx), int64(lastIdx)+1, int64(newFirstIdx+lenA)))
+	}
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (buf *BufferedFileAdapter) Slice(firstIdx int, lastIdx int) (slice SliceLike[byte, int]) {
+	return &BufferedFileSliceAdapter{
+		buf:   buf,
+		start: firstIdx,
+		len:   (lastIdx - firstIdx) + 1,
+	}
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) FirstIdx() (idx int) {
+	if buf.size == 0 {
+		return -1
+	}
+	return 0
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) LastIdx() (idx int) {
+	return int(buf.size) - 1
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) NextIdx(thisIdx int) (nextIdx int) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) PrevIdx(thisIdx int) (prevIdx int) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (buf *BufferedFileAdapter) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (buf *BufferedFileAdapter) Len() int {
+	return int(buf.size)
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (buf *BufferedFileAdapter) LenBetween(firstIdx int, lastIdx int) int {
+	if buf.size == 0 {
+		return 0
+	}
+	return (lastIdx - firstIdx) + 1
+}
+
+// ListLike
+
+// Ensure at least `n` empty capacity spaces exist to add new items without reallocating
+// the memory or perform any other expensive reorganization procedure
+//
+// If free space cannot be ensured and attempting to add `nMoreItems`
+// will definitely fail or cause undefined behaviour, `ok == false`
+func (buf *BufferedFileAdapter) TryEnsureFreeSlots(nMoreItems int) (ok bool) {
+	return buf.file != nil
+}
+
+// Insert `n` new slots directly before existing index, shifting all existing items
+// after them forward.
+//
+// Returns the first new slot and the last new slot, inclusive.
+//
+// The implementation should assume no checks need need to be made to ensure free space exists,
+// and calling this should not perform any reallocation(ok bool)
+//
+// May or may not work if attempting to insert items at an invalid index
+func (buf *BufferedFileAdapter) InsertSlotsAssumeCapacity(idx int, count int) (firstNewSlot int, lastNewSlot int) {
+	oldSize := buf.size
+	err := buf.resize(oldSize + int64(count))
+	if err != nil {
+		buf.recordErr(err)
+		return 0, 0
+	}
+	buf.recordErr(copyWithin(buf, buf.pageSize, int64(idx), int64(idx+count), oldSize-int64(idx)))
+	firstNewSlot = idx
+	lastNewSlot = (firstNewSlot + count) - 1
+	return
+}
+
+// Append `n` new slots at the end of the list.
+//
+// Returns the first new slot and the last new slot, inclusive.
+//
+// The implementation should assume no checks need need to be made to ensure free space exists,
+// and calling this should not perform any reallocation
+func (buf *BufferedFileAdapter) AppendSlotsAssumeCapacity(count int) (firstNewSlot int, lastNewSlot int) {
+	firstNewSlot = int(buf.size)
+	buf.recordErr(buf.resize(buf.size + int64(count)))
+	lastNewSlot = int(buf.size) - 1
+	return
+}
+
+// Remove all items between `firstRemoveIdx` and `lastRemovedIdx`, inclusive
+//
+// All items after `lastRemovedIdx` are shifted backward
+func (buf *BufferedFileAdapter) DeleteRange(firstRemovedIdx int, lastRemovedIdx int) {
+	err := copyWithin(buf, buf.pageSize, int64(lastRemovedIdx+1), int64(firstRemovedIdx), buf.size-int64(lastRemovedIdx+1))
+	if err != nil {
+		buf.recordErr(err)
+		return
+	}
+	buf.recordErr(buf.resize(buf.size - int64((lastRemovedIdx-firstRemovedIdx)+1)))
+}
+
+// Reset list to an empty state. The list's capacity may or may not be retained.
+func (buf *BufferedFileAdapter) Clear() {
+	buf.recordErr(buf.resize(0))
+}
+
+// Return the total number of values the slice/list can hold
+func (buf *BufferedFileAdapter) Cap() int {
+	return int(buf.size)
+}
+
+// ErrorReporter
+
+// Return the first I/O error encountered by this adapter
+// since the last call to `ResetErr()`, or `nil` if none occurred
+func (buf *BufferedFileAdapter) Err() error {
+	return buf.err
+}
+
+// Clear any error recorded by this adapter
+func (buf *BufferedFileAdapter) ResetErr() {
+	buf.err = nil
+}
+
+func (buf *BufferedFileAdapter) recordErr(err error) {
+	if err != nil && buf.err == nil {
+		buf.err = err
+	}
+}
+
+// Bulk access
+
+// Copy the bytes from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`,
+// a whole page at a time
+//
+// Any error is recorded and can be retrieved with `Err()`
+func (buf *BufferedFileAdapter) GetRange(firstIdx int, lastIdx int, dst []byte) {
+	_, err := buf.ReadAt(dst[:lastIdx-firstIdx+1], int64(firstIdx))
+	buf.recordErr(err)
+}
+
+// Set the `len(src)` bytes starting at `firstIdx` to the bytes in `src`,
+// a whole page at a time
+//
+// Any error is recorded and can be retrieved with `Err()`
+func (buf *BufferedFileAdapter) SetRange(firstIdx int, src []byte) {
+	_, err := buf.WriteAt(src, int64(firstIdx))
+	buf.recordErr(err)
+}
+
+// Page cache
+
+// Write all modified pages back to the file
+func (buf *BufferedFileAdapter) Flush() (err error) {
+	for page := buf.newest; page != nil; page = page.older {
+		if page.dirty {
+			err = firstErr(err, buf.writeBack(page))
+		}
+	}
+	buf.recordErr(err)
+	return
+}
+
+// Return the page with the given page index, loading it from
+// the file (and evicting the least recently used page) if needed
+func (buf *BufferedFileAdapter) getPage(pageIdx int64) (page *filePage, err error) {
+	page, cached := buf.pages[pageIdx]
+	if cached {
+		buf.unlinkPage(page)
+		buf.linkNewest(page)
+		return page, nil
+	}
+	if len(buf.pages) >= buf.maxPages {
+		page = buf.oldest
+		if page.dirty {
+			err = buf.writeBack(page)
+			if err != nil {
+				return nil, err
+			}
+		}
+		buf.unlinkPage(page)
+		delete(buf.pages, page.pageIdx)
+	} else {
+		page = &filePage{data: make([]byte, buf.pageSize)}
+	}
+	page.pageIdx = pageIdx
+	page.dirty = false
+	off := pageIdx * buf.pageSize
+	n := max(0, min(buf.pageSize, buf.size-off))
+	if n > 0 {
+		_, err = buf.file.ReadAt(page.data[:n], off)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		err = nil
+	}
+	clear(page.data[n:])
+	buf.pages[pageIdx] = page
+	buf.linkNewest(page)
+	return page, nil
+}
+
+func (buf *BufferedFileAdapter) writeBack(page *filePage) (err error) {
+	off := page.pageIdx * buf.pageSize
+	n := min(buf.pageSize, buf.size-off)
+	if n > 0 {
+		_, err = buf.file.WriteAt(page.data[:n], off)
+	}
+	if err == nil {
+		page.dirty = false
+	}
+	return
+}
+
+// Change the file size, discarding any cached data beyond the new size
+//
+// Bytes in cached pages beyond the current size are always zero,
+// matching what the file itself reads as after growing
+func (buf *BufferedFileAdapter) resize(newSize int64) (err error) {
+	err = buf.file.Truncate(newSize)
+	if err != nil {
+		return
+	}
+	if newSize < buf.size {
+		for pageIdx, page := range buf.pages {
+			off := pageIdx * buf.pageSize
+			if off >= newSize {
+				buf.unlinkPage(page)
+				delete(buf.pages, pageIdx)
+			} else if off+buf.pageSize > newSize {
+				clear(page.data[newSize-off:])
+			}
+		}
+	}
+	buf.size = newSize
+	return
+}
+
+func (buf *BufferedFileAdapter) linkNewest(page *filePage) {
+	page.older = buf.newest
+	page.newer = nil
+	if buf.newest != nil {
+		buf.newest.newer = page
+	}
+	buf.newest = page
+	if buf.oldest == nil {
+		buf.oldest = page
+	}
+}
+
+func (buf *BufferedFileAdapter) unlinkPage(page *filePage) {
+	if page.newer != nil {
+		page.newer.older = page.older
+	} else {
+		buf.newest = page.older
+	}
+	if page.older != nil {
+		page.older.newer = page.newer
+	} else {
+		buf.oldest = page.newer
+	}
+	page.newer = nil
+	page.older = nil
+}
+
+// File aliases
+
+// Read `len(b)` bytes starting at offset `off` through the page cache
+func (buf *BufferedFileAdapter) ReadAt(b []byte, off int64) (n int, err error) {
+	for n < len(b) && off < buf.size {
+		var page *filePage
+		page, err = buf.getPage(off / buf.pageSize)
+		if err != nil {
+			return
+		}
+		pageOff := off % buf.pageSize
+		copied := copy(b[n:], page.data[pageOff:min(buf.pageSize, buf.size-(off-pageOff))])
+		n += copied
+		off += int64(copied)
+	}
+	if n < len(b) {
+		err = io.EOF
+	}
+	return
+}
+
+// Write `b` starting at offset `off` through the page cache,
+// growing the file if needed
+func (buf *BufferedFileAdapter) WriteAt(b []byte, off int64) (n int, err error) {
+	if end := off + int64(len(b)); end > buf.size {
+		err = buf.resize(end)
+		if err != nil {
+			return
+		}
+	}
+	for n < len(b) {
+		var page *filePage
+		page, err = buf.getPage(off / buf.pageSize)
+		if err != nil {
+			return
+		}
+		copied := copy(page.data[off%buf.pageSize:], b[n:])
+		page.dirty = true
+		n += copied
+		off += int64(copied)
+	}
+	return
+}
+
+// Write all modified pages back to the file, then commit the file to stable storage
+func (buf *BufferedFileAdapter) Sync() error {
+	return firstErr(buf.Flush(), buf.file.Sync())
+}
+
+// Write all modified pages back to the file, then close the file
+func (buf *BufferedFileAdapter) Close() error {
+	return firstErr(buf.Flush(), buf.file.Close())
+}
+
+// Return the file this adapter reads and writes
+func (buf *BufferedFileAdapter) File() *os.File {
+	return buf.file
+}
+func (buf *BufferedFileAdapter) Name() string {
+	return buf.file.Name()
+}
+func (buf *BufferedFileAdapter) Stat() (os.FileInfo, error) {
+	return buf.file.Stat()
+}
+
+func (buf *BufferedFileAdapter) storage() (token any, known bool) {
+	return buf.file, true
+}
+
+var _ ListLike[byte, int] = (*BufferedFileAdapter)(nil)
+var _ ErrorReporter = (*BufferedFileAdapter)(nil)
+var _ BulkSliceLike[byte, int] = (*BufferedFileAdapter)(nil)
+var _ io.ReaderAt = (*BufferedFileAdapter)(nil)
+var _ io.WriterAt = (*BufferedFileAdapter)(nil)
+
+type BufferedFileSliceAdapter struct {
+	buf   *BufferedFileAdapter
+	start int
+	len   int
+}
+
+func (f *BufferedFileSliceAdapter) PreferLinearOps() bool {
+	return false
+}
+
+func (f *BufferedFileSliceAdapter) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (f *BufferedFileSliceAdapter) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (f *BufferedFileSliceAdapter) IdxValid(idx int) bool {
+	return idx >= 0 && idx < f.len
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (f *BufferedFileSliceAdapter) RangeValid(firstIdx int, lastIdx int) bool {
+	return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < f.len
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (f *BufferedFileSliceAdapter) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	return firstIdx + ((lastIdx - firstIdx) >> 1)
+}
+
+// Get the value at the provided index
+func (f *BufferedFileSliceAdapter) Get(idx int) (val byte) {
+	return f.buf.Get(f.start + idx)
+}
+
+// Set the value at the provided index to the given value
+func (f *BufferedFileSliceAdapter) Set(idx int, val byte) {
+	f.buf.Set(f.start+idx, val)
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (f *BufferedFileSliceAdapter) Move(oldIdx int, newIdx int) {
+	f.buf.Move(f.start+oldIdx, f.start+newIdx)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (f *BufferedFileSliceAdapter) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	f.buf.MoveRange(f.start+firstIdx, f.start+lastIdx, f.start+newFirstIdx)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (f *BufferedFileSliceAdapter) Slice(firstIdx int, lastIdx int) (slice SliceLike[byte, int]) {
+	return &BufferedFileSliceAdapter{
+		buf:   f.buf,
+		start: f.start + firstIdx,
+		len:   (lastIdx - firstIdx) + 1,
+	}
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) FirstIdx() (idx int) {
+	return 0
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) LastIdx() (idx int) {
+	return f.len - 1
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) NextIdx(thisIdx int) (nextIdx int) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) PrevIdx(thisIdx int) (prevIdx int) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (f *BufferedFileSliceAdapter) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (f *BufferedFileSliceAdapter) Len() int {
+	return f.len
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (f *BufferedFileSliceAdapter) LenBetween(firstIdx int, lastIdx int) int {
+	return (lastIdx - firstIdx) + 1
+}
+
+// Increment the start location (index/pointer/etc.) of this queue by
+// `n` positions. The new 'first' item in the queue should be the item
+// previously located at index `delta`
+func (f *BufferedFileSliceAdapter) IncrementStart(n int) {
+	f.start += n
+	f.len -= n
+}
+
+// Return the first I/O error encountered by the underlying `BufferedFileAdapter`
+// since the last call to `ResetErr()`, or `nil` if none occurred
+func (f *BufferedFileSliceAdapter) Err() error {
+	return f.buf.Err()
+}
+
+// Clear any error recorded by the underlying `BufferedFileAdapter`
+func (f *BufferedFileSliceAdapter) ResetErr() {
+	f.buf.ResetErr()
+}
+
+// Copy the bytes from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`,
+// a whole page at a time
+func (f *BufferedFileSliceAdapter) GetRange(firstIdx int, lastIdx int, dst []byte) {
+	f.buf.GetRange(f.start+firstIdx, f.start+lastIdx, dst)
+}
+
+// Set the `len(src)` bytes starting at `firstIdx` to the bytes in `src`,
+// a whole page at a time
+func (f *BufferedFileSliceAdapter) SetRange(firstIdx int, src []byte) {
+	f.buf.SetRange(f.start+firstIdx, src)
+}
+
+func (f *BufferedFileSliceAdapter) storage() (token any, known bool) {
+	return f.buf.storage()
+}
+
+var _ QueueLike[byte, int] = (*BufferedFileSliceAdapter)(nil)
+var _ ErrorReporter = (*BufferedFileSliceAdapter)(nil)
+var _ BulkSliceLike[byte, int] = (*BufferedFileSliceAdapter)(nil)
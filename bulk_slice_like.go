@@ -0,0 +1,40 @@
+package go_list_like
+
+type BulkSliceLike[T any, IDX Integer] interface {
+	SliceLike[T, IDX]
+	// Copy the values from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`
+	//
+	// Assumes `RangeValid(firstIdx, lastIdx) == true`, the indexes in the range are
+	// consecutive, and `len(dst) >= LenBetween(firstIdx, lastIdx)`
+	GetRange(firstIdx IDX, lastIdx IDX, dst []T)
+	// Set the `len(src)` values starting at `firstIdx` to the values in `src`
+	//
+	// Assumes `len(src)` consecutive indexes starting at `firstIdx` are valid
+	SetRange(firstIdx IDX, src []T)
+}
+
+func GetRange[T any, IDX Integer, S BulkSliceLike[T, IDX]](bulkSliceLike S, firstIdx IDX, lastIdx IDX, dst []T) {
+	bulkSliceLike.GetRange(firstIdx, lastIdx, dst)
+}
+func TryGetRange[T any, IDX Integer, S BulkSliceLike[T, IDX]](bulkSliceLike S, firstIdx IDX, lastIdx IDX, dst []T) (ok bool) {
+	ok = bulkSliceLike.RangeValid(firstIdx, lastIdx) && len(dst) >= int(bulkSliceLike.LenBetween(firstIdx, lastIdx))
+	if !ok {
+		return
+	}
+	bulkSliceLike.GetRange(firstIdx, lastIdx, dst)
+	return
+}
+func SetRange[T any, IDX Integer, S BulkSliceLike[T, IDX]](bulkSliceLike S, firstIdx IDX, src []T) {
+	bulkSliceLike.SetRange(firstIdx, src)
+}
+func TrySetRange[T any, IDX Integer, S BulkSliceLike[T, IDX]](bulkSliceLike S, firstIdx IDX, src []T) (ok bool) {
+	if len(src) == 0 {
+		return true
+	}
+	ok = bulkSliceLike.RangeValid(firstIdx, firstIdx+IDX(len(src)-1))
+	if !ok {
+		return
+	}
+	bulkSliceLike.SetRange(firstIdx, src)
+	return
+}
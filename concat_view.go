@@ -0,0 +1,248 @@
+package go_list_like
+
+// One of the slices joined by a `ConcatView[T, IDX]`, which may use any index type
+//
+// Create one with `NewConcatPart()`
+type ConcatPart[T any] interface {
+	partPreferLinearOps() bool
+	partLen() int
+	partGet(pos int) (val T)
+	partSet(pos int, val T)
+	partSlice(firstPos int, lastPos int) ConcatPart[T]
+	partErr() error
+	partResetErr()
+}
+
+type concatPart[T any, IDX Integer] struct {
+	slice SliceLike[T, IDX]
+}
+
+// Wrap `slice` so that it can be joined with slices of other index types by `NewConcatView()`
+func NewConcatPart[T any, IDX Integer, S SliceLike[T, IDX]](slice S) ConcatPart[T] {
+	return concatPart[T, IDX]{
+		slice: slice,
+	}
+}
+
+func (part concatPart[T, IDX]) idx(pos int) IDX {
+	return part.slice.NthNextIdx(part.slice.FirstIdx(), IDX(pos))
+}
+func (part concatPart[T, IDX]) partPreferLinearOps() bool {
+	return part.slice.PreferLinearOps()
+}
+func (part concatPart[T, IDX]) partLen() int {
+	return int(part.slice.Len())
+}
+func (part concatPart[T, IDX]) partGet(pos int) (val T) {
+	return part.slice.Get(part.idx(pos))
+}
+func (part concatPart[T, IDX]) partSet(pos int, val T) {
+	part.slice.Set(part.idx(pos), val)
+}
+func (part concatPart[T, IDX]) partSlice(firstPos int, lastPos int) ConcatPart[T] {
+	first := part.idx(firstPos)
+	return NewConcatPart(part.slice.Slice(first, part.slice.NthNextIdx(first, IDX(lastPos-firstPos))))
+}
+func (part concatPart[T, IDX]) partErr() error {
+	return CheckErr(part.slice)
+}
+func (part concatPart[T, IDX]) partResetErr() {
+	ResetErr(part.slice)
+}
+
+// Presents several slices, one after another, as a single `SliceLike[T, IDX]`
+//
+// The slices may each use a different index type. The view numbers its values by their
+// position from `0` to `Len() - 1`, so finding the value at an index walks the part that
+// holds it from its first index. If any part has `PreferLinearOps() == true`, so does the view
+//
+// `Move()` and `MoveRange()` can move values between parts, but never change how many
+// values each part holds
+type ConcatView[T any, IDX Integer] struct {
+	parts []ConcatPart[T]
+}
+
+func NewConcatView[T any, IDX Integer](parts ...ConcatPart[T]) ConcatView[T, IDX] {
+	return ConcatView[T, IDX]{
+		parts: parts,
+	}
+}
+
+// Return the part that holds the value at `idx`, and the position of the value within it
+func (view ConcatView[T, IDX]) locate(idx IDX) (part ConcatPart[T], pos int) {
+	pos = int(idx)
+	for _, part = range view.parts {
+		partLen := part.partLen()
+		if pos < partLen {
+			return
+		}
+		pos -= partLen
+	}
+	return nil, 0
+}
+
+// SliceLike
+
+func (view ConcatView[T, IDX]) PreferLinearOps() bool {
+	for _, part := range view.parts {
+		if part.partPreferLinearOps() {
+			return true
+		}
+	}
+	return false
+}
+
+func (view ConcatView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (view ConcatView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (view ConcatView[T, IDX]) IdxValid(idx IDX) bool {
+	return idx >= 0 && idx < view.Len()
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view ConcatView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < view.Len()
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view ConcatView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	return firstIdx + ((lastIdx - firstIdx) >> 1)
+}
+
+// Get the value at the provided index
+func (view ConcatView[T, IDX]) Get(idx IDX) (val T) {
+	part, pos := view.locate(idx)
+	return part.partGet(pos)
+}
+
+// Set the value at the provided index to the given value
+func (view ConcatView[T, IDX]) Set(idx IDX, val T) {
+	part, pos := view.locate(idx)
+	part.partSet(pos, val)
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view ConcatView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	moveRangeByValues_internal(view, oldIdx, oldIdx, newIdx)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view ConcatView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	moveRangeByValues_internal(view, firstIdx, lastIdx, newFirstIdx)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view ConcatView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	var parts []ConcatPart[T]
+	first, last := int(firstIdx), int(lastIdx)
+	for _, part := range view.parts {
+		partLen := part.partLen()
+		if first < partLen && last >= 0 && partLen > 0 {
+			parts = append(parts, part.partSlice(max(first, 0), min(last, partLen-1)))
+		}
+		first -= partLen
+		last -= partLen
+	}
+	return NewConcatView[T, IDX](parts...)
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) FirstIdx() (idx IDX) {
+	return 0
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) LastIdx() (idx IDX) {
+	return view.Len() - 1
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ConcatView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view ConcatView[T, IDX]) Len() IDX {
+	total := 0
+	for _, part := range view.parts {
+		total += part.partLen()
+	}
+	return IDX(total)
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view ConcatView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	return (lastIdx - firstIdx) + 1
+}
+
+// ErrorReporter
+
+// Return the first error recorded by any of the parts, if any
+func (view ConcatView[T, IDX]) Err() error {
+	for _, part := range view.parts {
+		if err := part.partErr(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clear any error recorded by the parts
+func (view ConcatView[T, IDX]) ResetErr() {
+	for _, part := range view.parts {
+		part.partResetErr()
+	}
+}
+
+var _ SliceLike[byte, int] = ConcatView[byte, int]{}
+var _ ErrorReporter = ConcatView[byte, int]{}
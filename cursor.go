@@ -0,0 +1,199 @@
+package go_list_like
+
+// A position within a `SliceLike` that remembers both its index and how many places
+// it is after the first index
+//
+// Moving a cursor by `n` places only walks `n` indexes, so a sequence of nearby positions
+// can be visited in O(1) amortized time per step even when `PreferLinearOps() == true`,
+// where `NthIdx()` would walk from an end of the slice every time
+//
+// A cursor may be positioned before the first index or after the last index, in which case
+// `Valid() == false`, but it still remembers its position and can be moved back into the slice.
+// If `IDX` is unsigned, positions before the first index cannot be represented, and a cursor
+// can only be moved backward with `MoveTo()` or `Seek()`
+type Cursor[T any, IDX Integer] struct {
+	slice SliceLike[T, IDX]
+	idx   IDX
+	pos   IDX
+}
+
+// Return a cursor positioned at the first index of `slice`
+func NewCursor[T any, IDX Integer, S SliceLike[T, IDX]](slice S) Cursor[T, IDX] {
+	return Cursor[T, IDX]{
+		slice: slice,
+		idx:   slice.FirstIdx(),
+		pos:   0,
+	}
+}
+
+// Return a cursor positioned at `idx`, which must be exactly `pos` places after the first index of `slice`
+//
+// If the cursor is only ever moved with `Seek()`, `pos` may instead count places from any fixed index
+func NewCursorAt[T any, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX, pos IDX) Cursor[T, IDX] {
+	return Cursor[T, IDX]{
+		slice: slice,
+		idx:   idx,
+		pos:   pos,
+	}
+}
+
+// Return the `SliceLike` the cursor moves over
+func (c *Cursor[T, IDX]) Slice() SliceLike[T, IDX] {
+	return c.slice
+}
+
+// Return the index the cursor is currently at
+func (c *Cursor[T, IDX]) Idx() IDX {
+	return c.idx
+}
+
+// Return the number of places the cursor is after the first index
+func (c *Cursor[T, IDX]) Pos() IDX {
+	return c.pos
+}
+
+// Return whether the cursor is at a valid index
+func (c *Cursor[T, IDX]) Valid() bool {
+	return c.slice.IdxValid(c.idx)
+}
+
+// Move the cursor to the next index
+func (c *Cursor[T, IDX]) Next() {
+	if !c.Valid() {
+		c.MoveTo(c.pos + 1)
+		return
+	}
+	c.idx = c.slice.NextIdx(c.idx)
+	c.pos += 1
+}
+
+// Move the cursor to the previous index
+func (c *Cursor[T, IDX]) Prev() {
+	if !c.Valid() {
+		c.MoveTo(c.pos - 1)
+		return
+	}
+	c.idx = c.slice.PrevIdx(c.idx)
+	c.pos -= 1
+}
+
+// Move the cursor `n` places forward, or backward if `n` is negative
+//
+// If `IDX` is unsigned, `n` can never be negative, so use `MoveTo()` or `Seek()` to move backward
+func (c *Cursor[T, IDX]) Advance(n IDX) {
+	if !c.Valid() {
+		c.MoveTo(c.pos + n)
+		return
+	}
+	if n < 0 {
+		c.idx = c.slice.NthPrevIdx(c.idx, -n)
+	} else {
+		c.idx = c.slice.NthNextIdx(c.idx, n)
+	}
+	c.pos += n
+}
+
+// Move the cursor to the index `pos` places after the first index, walking
+// from whichever of the current index, the first index, or the last index is closest
+func (c *Cursor[T, IDX]) MoveTo(pos IDX) {
+	length := c.slice.Len()
+	switch {
+	case pos < 0:
+		c.idx = c.slice.PrevIdx(c.slice.FirstIdx())
+	case pos >= length:
+		c.idx = c.slice.NextIdx(c.slice.LastIdx())
+	default:
+		fromFirst := pos
+		fromLast := length - 1 - pos
+		var fromCurrent IDX
+		currentValid := c.Valid()
+		if pos >= c.pos {
+			fromCurrent = pos - c.pos
+		} else {
+			fromCurrent = c.pos - pos
+		}
+		switch {
+		case currentValid && fromCurrent <= fromFirst && fromCurrent <= fromLast:
+			if pos >= c.pos {
+				c.idx = c.slice.NthNextIdx(c.idx, fromCurrent)
+			} else {
+				c.idx = c.slice.NthPrevIdx(c.idx, fromCurrent)
+			}
+		case fromFirst <= fromLast:
+			c.idx = c.slice.NthNextIdx(c.slice.FirstIdx(), fromFirst)
+		default:
+			c.idx = c.slice.NthPrevIdx(c.slice.LastIdx(), fromLast)
+		}
+	}
+	c.pos = pos
+}
+
+// Move the cursor to position `pos`, walking only from the current index
+//
+// Unlike `MoveTo()`, this never walks from the first or last index, so it costs exactly the
+// distance moved. The distance is never negative, so this can move backward when `IDX` is unsigned
+//
+// Assumes `Valid() == true` and that `pos` is within the slice
+func (c *Cursor[T, IDX]) Seek(pos IDX) {
+	if pos >= c.pos {
+		c.idx = c.slice.NthNextIdx(c.idx, pos-c.pos)
+	} else {
+		c.idx = c.slice.NthPrevIdx(c.idx, c.pos-pos)
+	}
+	c.pos = pos
+}
+
+// Get the value at the cursor
+//
+// Assumes `Valid() == true`
+func (c *Cursor[T, IDX]) Get() (val T) {
+	return c.slice.Get(c.idx)
+}
+
+// Set the value at the cursor
+//
+// Assumes `Valid() == true`
+func (c *Cursor[T, IDX]) Set(val T) {
+	c.slice.Set(c.idx, val)
+}
+
+// Insert `val` directly before the cursor, which stays on the same value it was on before.
+// If the cursor is after the last index, `val` is appended instead. If the cursor is before
+// the first index, `val` is inserted at the first index and the cursor stays before it
+//
+// Assumes the cursor was created from a `ListLike[T, IDX]`
+func (c *Cursor[T, IDX]) InsertBefore(val T) {
+	list := c.slice.(ListLike[T, IDX])
+	if c.pos < 0 {
+		if list.Len() == 0 {
+			AppendVar(list, val)
+		} else {
+			InsertVar(list, list.FirstIdx(), val)
+		}
+		c.idx = list.PrevIdx(list.FirstIdx())
+		return
+	}
+	var newIdx IDX
+	if c.Valid() {
+		newIdx, _ = InsertVar(list, c.idx, val)
+	} else {
+		newIdx, _ = AppendVar(list, val)
+	}
+	c.idx = list.NextIdx(newIdx)
+	c.pos += 1
+}
+
+// Delete the value at the cursor, moving the cursor onto the value that followed it
+//
+// Assumes `Valid() == true` and the cursor was created from a `ListLike[T, IDX]`
+func (c *Cursor[T, IDX]) Delete() {
+	list := c.slice.(ListLike[T, IDX])
+	prevIdx := list.PrevIdx(c.idx)
+	hasPrev := list.IdxValid(prevIdx)
+	list.DeleteRange(c.idx, c.idx)
+	if hasPrev {
+		c.idx = list.NextIdx(prevIdx)
+	} else {
+		c.idx = list.FirstIdx()
+	}
+}
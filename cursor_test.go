@@ -0,0 +1,265 @@
+package go_list_like
+
+import (
+	"slices"
+	"testing"
+)
+
+const (
+	cursorOpNext byte = iota
+	cursorOpPrev
+	cursorOpAdvance
+	cursorOpMoveTo
+	cursorOpSet
+	cursorOpInsertBefore
+	cursorOpDelete
+	cursorOpCount
+)
+
+func Fuzz_Cursor_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4}, []byte{0, 0, 2, 3, 4, 9, 5, 1, 6, 1, 1, 3, 200})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109}, []byte{3, 6, 6, 6, 2, 250, 1, 5, 7, 0, 0, 0, 0, 0, 0, 0, 1})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, []byte{3, 20, 1, 1, 4, 11, 5, 99, 6, 6, 6, 6, 2, 3, 3, 0})
+	f.Add([]byte{4, 5, 6}, []byte{1, 0, 5, 10, 5, 11, 0, 0, 5, 12, 1, 0, 5, 13, 0, 0, 6, 0})
+	f.Add(nilSlice, []byte{1, 0, 5, 10, 0, 0, 6, 0, 5, 11})
+	f.Fuzz(func(t *testing.T, initialData []byte, opData []byte) {
+		expect := slices.Clone(initialData)
+		expectPos := 0
+		linkedList := NewLinkedList(slices.Clone(initialData))
+		sliceAdapter := NewSliceAdapter(slices.Clone(initialData))
+		lists := []ListLike[byte, int]{&linkedList, &sliceAdapter}
+		cursors := make([]Cursor[byte, int], len(lists))
+		for i, list := range lists {
+			cursors[i] = NewCursor(list)
+		}
+		for i := 0; i < len(opData); i += 2 {
+			op := opData[i] % cursorOpCount
+			var arg byte
+			if i+1 < len(opData) {
+				arg = opData[i+1]
+			}
+			switch op {
+			case cursorOpNext:
+				expectPos += 1
+			case cursorOpPrev:
+				expectPos -= 1
+			case cursorOpAdvance:
+				expectPos += int(int8(arg) % 8)
+			case cursorOpMoveTo:
+				expectPos = int(arg)%(len(expect)+3) - 1
+			case cursorOpSet:
+				if expectPos < 0 || expectPos >= len(expect) {
+					continue
+				}
+				expect[expectPos] = arg
+			case cursorOpInsertBefore:
+				if expectPos > len(expect) {
+					continue
+				}
+				if expectPos < 0 {
+					expect = slices.Insert(expect, 0, arg)
+					break
+				}
+				expect = slices.Insert(expect, expectPos, arg)
+				expectPos += 1
+			case cursorOpDelete:
+				if expectPos < 0 || expectPos >= len(expect) {
+					continue
+				}
+				expect = slices.Delete(expect, expectPos, expectPos+1)
+			}
+			for c := range cursors {
+				cursor := &cursors[c]
+				switch op {
+				case cursorOpNext:
+					cursor.Next()
+				case cursorOpPrev:
+					cursor.Prev()
+				case cursorOpAdvance:
+					cursor.Advance(int(int8(arg) % 8))
+				case cursorOpMoveTo:
+					cursor.MoveTo(expectPos)
+				case cursorOpSet:
+					cursor.Set(arg)
+				case cursorOpInsertBefore:
+					cursor.InsertBefore(arg)
+				case cursorOpDelete:
+					cursor.Delete()
+				}
+				list := lists[c]
+				expectValid := expectPos >= 0 && expectPos < len(expect)
+				if cursor.Pos() != expectPos || cursor.Valid() != expectValid {
+					t.Errorf("\ntest case failed: cursor position mismatch after op %d on %T\nEXP POS: %d (valid = %t)\nGOT POS: %d (valid = %t)\n", op, list, expectPos, expectValid, cursor.Pos(), cursor.Valid())
+					return
+				}
+				if expectValid && (cursor.Idx() != NthIdx(list, expectPos) || cursor.Get() != expect[expectPos]) {
+					t.Errorf("\ntest case failed: cursor value mismatch after op %d on %T\nEXP: idx %d = %d\nGOT: idx %d = %d\n", op, list, NthIdx(list, expectPos), expect[expectPos], cursor.Idx(), cursor.Get())
+					return
+				}
+				if got := collectBytes(list); !slices.Equal(expect, got) {
+					t.Errorf("\ntest case failed: list mismatch after op %d on %T\nEXP: %v\nGOT: %v\n", op, list, expect, got)
+					return
+				}
+			}
+		}
+	})
+}
+
+func Fuzz_SwizzleLinear_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4}, []byte{0, 1, 2, 0, 1})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109}, []byte{2, 2, 1, 0, 1, 2, 0, 3})
+	f.Fuzz(func(t *testing.T, data []byte, selectorData []byte) {
+		const nSlices = 3
+		linked := make([]*LinkedList[byte], nSlices)
+		adapters := make([]*SliceAdapter[byte], nSlices)
+		for s := range nSlices {
+			vals := make([]byte, len(data))
+			for i, b := range data {
+				vals[i] = b + byte(s)
+			}
+			list := NewLinkedList(slices.Clone(vals))
+			linked[s] = &list
+			adapter := NewSliceAdapter(vals)
+			adapters[s] = &adapter
+		}
+		selectors := make([]int, len(selectorData))
+		for i, b := range selectorData {
+			selectors[i] = int(b % nSlices)
+		}
+		selectorAdapter := NewSliceAdapter(selectors)
+		expSlices := NewSliceAdapter(adapters)
+		gotSlices := NewSliceAdapter(linked)
+		expDest := NewSliceAdapter(make([]byte, len(selectors)))
+		gotDest := NewSliceAdapter(make([]byte, len(selectors)))
+		expN, expAllSel, expAllDest := Swizzle(&expSlices, &selectorAdapter, &expDest)
+		gotN, gotAllSel, gotAllDest := Swizzle(&gotSlices, &selectorAdapter, &gotDest)
+		if expN != gotN || expAllSel != gotAllSel || expAllDest != gotAllDest || !slices.Equal(expDest.GoSlice(), gotDest.GoSlice()) {
+			t.Errorf("\ntest case failed: swizzle over linked lists differs from slices\nEXP: %d %t %t %v\nGOT: %d %t %t %v\n", expN, expAllSel, expAllDest, expDest.GoSlice(), gotN, gotAllSel, gotAllDest, gotDest.GoSlice())
+		}
+	})
+}
+
+// Presents a `SliceLike[byte, int]` with `uint` indexes, so that linear-preferring
+// slices can be checked with an unsigned `IDX`
+type uintSlice struct {
+	inner SliceLike[byte, int]
+}
+
+func (s uintSlice) PreferLinearOps() bool {
+	return s.inner.PreferLinearOps()
+}
+func (s uintSlice) ConsecutiveIndexesInOrder() bool {
+	return s.inner.ConsecutiveIndexesInOrder()
+}
+func (s uintSlice) AllIndexesLessThanLenValid() bool {
+	return s.inner.AllIndexesLessThanLenValid()
+}
+func (s uintSlice) IdxValid(idx uint) bool {
+	return s.inner.IdxValid(int(idx))
+}
+func (s uintSlice) RangeValid(firstIdx uint, lastIdx uint) bool {
+	return s.inner.RangeValid(int(firstIdx), int(lastIdx))
+}
+func (s uintSlice) SplitRange(firstIdx uint, lastIdx uint) uint {
+	return uint(s.inner.SplitRange(int(firstIdx), int(lastIdx)))
+}
+func (s uintSlice) Get(idx uint) byte {
+	return s.inner.Get(int(idx))
+}
+func (s uintSlice) Set(idx uint, val byte) {
+	s.inner.Set(int(idx), val)
+}
+func (s uintSlice) Move(oldIdx uint, newIdx uint) {
+	s.inner.Move(int(oldIdx), int(newIdx))
+}
+func (s uintSlice) MoveRange(firstIdx uint, lastIdx uint, newFirstIdx uint) {
+	s.inner.MoveRange(int(firstIdx), int(lastIdx), int(newFirstIdx))
+}
+func (s uintSlice) Slice(firstIdx uint, lastIdx uint) SliceLike[byte, uint] {
+	return uintSlice{s.inner.Slice(int(firstIdx), int(lastIdx))}
+}
+func (s uintSlice) FirstIdx() uint {
+	return uint(s.inner.FirstIdx())
+}
+func (s uintSlice) LastIdx() uint {
+	return uint(s.inner.LastIdx())
+}
+func (s uintSlice) NextIdx(thisIdx uint) uint {
+	return uint(s.inner.NextIdx(int(thisIdx)))
+}
+func (s uintSlice) PrevIdx(thisIdx uint) uint {
+	return uint(s.inner.PrevIdx(int(thisIdx)))
+}
+
+// Walks one index at a time, as a linear-preferring slice with an unsigned `IDX` would,
+// so a wrapped-around negative `n` walks off the end instead of back
+func (s uintSlice) NthNextIdx(thisIdx uint, n uint) uint {
+	for ; n > 0 && s.IdxValid(thisIdx); n -= 1 {
+		thisIdx = s.NextIdx(thisIdx)
+	}
+	return thisIdx
+}
+func (s uintSlice) NthPrevIdx(thisIdx uint, n uint) uint {
+	for ; n > 0 && s.IdxValid(thisIdx); n -= 1 {
+		thisIdx = s.PrevIdx(thisIdx)
+	}
+	return thisIdx
+}
+func (s uintSlice) Len() uint {
+	return uint(s.inner.Len())
+}
+func (s uintSlice) LenBetween(firstIdx uint, lastIdx uint) uint {
+	return uint(s.inner.LenBetween(int(firstIdx), int(lastIdx)))
+}
+
+func Fuzz_CursorUnsigned_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, byte(5))
+	f.Add([]byte{0, 1, 2, 3, 4, 6, 7, 8, 9, 10}, byte(5))
+	f.Add([]byte{1, 3, 5, 5, 5, 7, 9, 11, 13, 15, 17, 19, 21}, byte(19))
+	f.Add([]byte{6, 6, 6, 6}, byte(0))
+	f.Fuzz(func(t *testing.T, a []byte, b byte) {
+		slices.Sort(a)
+		list := NewLinkedList(slices.Clone(a))
+		slice := uintSlice{&list}
+		cursor := NewCursor(slice)
+		for _, pos := range []int{len(a) - 1, len(a) / 2, 0, len(a) / 3} {
+			if pos < 0 || pos >= len(a) {
+				continue
+			}
+			cursor.MoveTo(uint(pos))
+			if !cursor.Valid() || cursor.Get() != a[pos] {
+				t.Errorf("\ntest case failed: unsigned cursor MoveTo(%d)\nSLICE: %v\nEXP: %d\nGOT: valid = %t\n", pos, a, a[pos], cursor.Valid())
+				return
+			}
+			cursor.Seek(0)
+			if cursor.Get() != a[0] {
+				t.Errorf("\ntest case failed: unsigned cursor Seek(0) from %d\nSLICE: %v\nEXP: %d\nGOT: %d\n", pos, a, a[0], cursor.Get())
+				return
+			}
+			if idx, ok := TryNthIdx(slice, uint(pos)); !ok || slice.Get(idx) != a[pos] {
+				t.Errorf("\ntest case failed: unsigned TryNthIdx(%d)\nSLICE: %v\nEXP: %d\nGOT: ok = %t\n", pos, a, a[pos], ok)
+				return
+			}
+		}
+		pos := func(idx uint) int {
+			if !slice.IdxValid(idx) {
+				return len(a)
+			}
+			return int(slice.LenBetween(slice.FirstIdx(), idx)) - 1
+		}
+		expLower, expFound := slices.BinarySearch(a, b)
+		lower, found := LowerBoundImplicit(slice, b)
+		if pos(lower) != expLower || found != expFound {
+			t.Errorf("\ntest case failed: unsigned LowerBound(%d)\nSLICE: %v\nEXP: %d, %t\nGOT: %d, %t\n", b, a, expLower, expFound, pos(lower), found)
+		}
+		searchIdx, found := SortedSearch(slice, b, EqualImplicit, GreaterThanImplicit)
+		if found != expFound || (found && pos(searchIdx) != expLower) {
+			t.Errorf("\ntest case failed: unsigned SortedSearch(%d)\nSLICE: %v\nEXP: %d, %t\nGOT: %d, %t\n", b, a, expLower, expFound, pos(searchIdx), found)
+		}
+	})
+}
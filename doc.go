@@ -0,0 +1,8 @@
+// Package go_list_like provides interfaces for types that can behave like a 'List' or 'Vector',
+// and a collection of functions that operate on any type implementing them
+//
+// The module declares `go 1.22`, but the range-over-func iterators in iter_funcs.go (`All()`,
+// `Values()`, `Backward()`, `AppendSeq()`, `CollectInto()`, and the rest of that file) are
+// only compiled with Go 1.23 or newer, because they depend on the standard `iter` package.
+// With a Go 1.22 toolchain the rest of the package builds normally, but those functions do not exist
+package go_list_like
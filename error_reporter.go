@@ -0,0 +1,107 @@
+package go_list_like
+
+import "errors"
+
+// Implemented by SliceLike types whose operations can fail for reasons
+// outside the caller's control (for example I/O errors when backed by a file)
+//
+// Because the `SliceLike` and `ListLike` methods have no error results, implementations
+// record the first error encountered and keep reporting it until `ResetErr()` is called
+//
+// Types that never fail (such as `SliceAdapter[T]`) do not need to implement this interface,
+// and are treated as never having an error by the functions below
+type ErrorReporter interface {
+	// Return the first error encountered since the last call to `ResetErr()`,
+	// or `nil` if no error has occurred
+	Err() error
+	// Clear any recorded error
+	ResetErr()
+}
+
+var (
+	ErrInvalidIndex   = errors.New("go_list_like: invalid index")
+	ErrInvalidRange   = errors.New("go_list_like: invalid index range")
+	ErrNoFreeSlots    = errors.New("go_list_like: could not ensure enough free slots")
+	ErrIncompleteCopy = errors.New("go_list_like: could not copy all values")
+	ErrReadOnly       = errors.New("go_list_like: slice is read-only")
+)
+
+// Return the error recorded by `slice` if it implements `ErrorReporter`, otherwise `nil`
+func CheckErr[T any, IDX Integer, S SliceLike[T, IDX]](slice S) (err error) {
+	if reporter, isReporter := any(slice).(ErrorReporter); isReporter {
+		err = reporter.Err()
+	}
+	return
+}
+
+// Clear the error recorded by `slice` if it implements `ErrorReporter`
+func ResetErr[T any, IDX Integer, S SliceLike[T, IDX]](slice S) {
+	if reporter, isReporter := any(slice).(ErrorReporter); isReporter {
+		reporter.ResetErr()
+	}
+}
+
+func TryGetErr[T any, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX) (val T, err error) {
+	val, ok := TryGet(slice, idx)
+	err = CheckErr(slice)
+	if err == nil && !ok {
+		err = ErrInvalidIndex
+	}
+	return
+}
+func TrySetErr[T any, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX, val T) (err error) {
+	ok := TrySet(slice, idx, val)
+	err = CheckErr(slice)
+	if err == nil && !ok {
+		err = ErrInvalidIndex
+	}
+	return
+}
+func TryCopyErr[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](source S1, dest S2) (nCopied IDX1, err error) {
+	nCopied, _, _, _, _ = Copy(source, dest)
+	err = firstErr(CheckErr(source), CheckErr(dest))
+	return
+}
+func TryAppendErr[T any, IDX1 Integer, IDX2 Integer, L ListLike[T, IDX1], S SliceLike[T, IDX2]](list L, vals S) (firstAppendedIdx IDX1, lastAppendedIdx IDX1, err error) {
+	firstAppendedIdx, lastAppendedIdx, ok := TryAppendSlots(list, IDX1(vals.Len()))
+	if !ok {
+		err = firstErr(CheckErr(list), ErrNoFreeSlots)
+		return
+	}
+	_, fs, fd, _, _ := CopyToRange(vals, list, firstAppendedIdx, lastAppendedIdx)
+	err = firstErr(CheckErr(list), CheckErr(vals))
+	if err == nil && !(fs && fd) {
+		err = ErrIncompleteCopy
+	}
+	return
+}
+func TryInsertErr[T any, IDX1 Integer, IDX2 Integer, L ListLike[T, IDX1], S SliceLike[T, IDX2]](list L, idx IDX1, vals S) (firstInsertedIdx IDX1, lastInsertedIdx IDX1, err error) {
+	firstInsertedIdx, lastInsertedIdx, ok := TryInsertSlots(list, idx, IDX1(vals.Len()))
+	if !ok {
+		err = firstErr(CheckErr(list), ErrNoFreeSlots)
+		return
+	}
+	_, fs, fd, _, _ := CopyToRange(vals, list, firstInsertedIdx, lastInsertedIdx)
+	err = firstErr(CheckErr(list), CheckErr(vals))
+	if err == nil && !(fs && fd) {
+		err = ErrIncompleteCopy
+	}
+	return
+}
+func TryDeleteRangeErr[T any, IDX Integer, L ListLike[T, IDX]](list L, firstDeletedIdx IDX, lastDeletedIdx IDX) (err error) {
+	ok := TryDeleteRange(list, firstDeletedIdx, lastDeletedIdx)
+	err = CheckErr(list)
+	if err == nil && !ok {
+		err = ErrInvalidRange
+	}
+	return
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
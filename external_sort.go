@@ -0,0 +1,330 @@
+package go_list_like
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"io"
+	"os"
+)
+
+// The maximum number of sorted runs `ExternalSort()` merges at once.
+// When there are more runs than this, groups of runs are merged in several passes
+// so that the number of open temporary files stays bounded
+const ExternalSortMaxFanIn = 64
+
+// How often (in records) `ExternalSort()` checks for cancellation and reports progress while merging
+const externalSortCheckInterval = 4096
+
+type ExternalSortPhase int
+
+const (
+	// Records are being read from the source and written to sorted temporary runs
+	ExternalSortCreatingRuns ExternalSortPhase = iota
+	// Groups of runs are being merged into longer runs, until few enough remain for the final merge
+	ExternalSortMergingRuns
+	// The remaining runs are being merged into the destination
+	ExternalSortWritingOutput
+)
+
+type externalSortProgressKey struct{}
+
+// Return a copy of `ctx` that causes `ExternalSort()` to call `report` as it makes progress
+//
+// `recordsDone` counts the records processed so far in the current phase, out of `recordsTotal`
+func WithExternalSortProgress(ctx context.Context, report func(phase ExternalSortPhase, recordsDone int, recordsTotal int)) context.Context {
+	return context.WithValue(ctx, externalSortProgressKey{}, report)
+}
+
+func reportExternalSortProgress(ctx context.Context, phase ExternalSortPhase, recordsDone int, recordsTotal int) {
+	if report, hasReport := ctx.Value(externalSortProgressKey{}).(func(ExternalSortPhase, int, int)); hasReport {
+		report(phase, recordsDone, recordsTotal)
+	}
+}
+
+// Sort all values in `source` into `dest` using temporary files, for data sets too large to fit in memory
+//
+// `source` is read once in index order, in runs of at most `memoryBudget / codec.Size()` values.
+// Each run is stable sorted in memory and written to a temporary file in `tempDir` (or the default
+// temporary directory if `tempDir == ""`) using `codec`. Groups of runs are then k-way merged into longer
+// runs until few enough remain, and only then is `dest` cleared and the remaining runs merged into it.
+// If `source` and `dest` are known to use different storage, up to `ExternalSortMaxFanIn` runs are merged
+// straight into `dest`. Otherwise `dest` may share storage with `source`, so every run is first merged
+// into a single temporary run, which costs an extra read and write of every value. Because `dest` is not
+// changed until every value is safely in the remaining runs, both may refer to the same list, and an error
+// or cancellation before then leaves `dest` untouched. The sort is stable.
+//
+// Cancellation is checked between runs and periodically while merging, but not during the final merge
+// into `dest`, so that `dest` is never left partly written by a cancellation. An I/O error while reading
+// the remaining runs can still leave `dest` incomplete. Progress is reported to any function attached
+// with `WithExternalSortProgress()`. Errors recorded by `source` or `dest` (see `ErrorReporter`) are also
+// returned. All temporary files are removed before returning
+func ExternalSort[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](ctx context.Context, source S, dest L, greaterThan func(a T, b T) (isGreaterThan bool), codec RecordCodec[T], memoryBudget int, tempDir string) (err error) {
+	recordSize := codec.Size()
+	runLen := max(1, memoryBudget/recordSize)
+	total := int(source.Len())
+	var runs []*externalSortRun[T]
+	defer func() {
+		for _, run := range runs {
+			run.remove()
+		}
+	}()
+	vals := make([]T, 0, min(runLen, total))
+	valsSlice := NewSliceAdapterIndirect(&vals)
+	nRead := 0
+	idx := source.FirstIdx()
+	ok := source.IdxValid(idx)
+	for ok {
+		vals = vals[:0]
+		for ok && len(vals) < runLen {
+			vals = append(vals, source.Get(idx))
+			idx = source.NextIdx(idx)
+			ok = source.IdxValid(idx)
+		}
+		nRead += len(vals)
+		err = firstErr(CheckErr(source), ctx.Err())
+		if err != nil {
+			return
+		}
+		StableSort(valsSlice, greaterThan)
+		var run *externalSortRun[T]
+		run, err = newExternalSortRun(tempDir, codec, len(runs))
+		if err != nil {
+			return
+		}
+		runs = append(runs, run)
+		for _, val := range vals {
+			err = run.write(val)
+			if err != nil {
+				return
+			}
+		}
+		err = run.finishWriting()
+		if err != nil {
+			return
+		}
+		reportExternalSortProgress(ctx, ExternalSortCreatingRuns, nRead, total)
+	}
+	vals = nil
+	// Clearing `dest` must not lose values that are still only in `source`
+	maxFinalRuns := 1
+	if storageDistinct(source, dest) {
+		maxFinalRuns = ExternalSortMaxFanIn
+	}
+	for len(runs) > maxFinalRuns {
+		runs, err = mergeExternalSortRunGroups(ctx, runs, greaterThan, codec, memoryBudget, tempDir, nRead)
+		if err != nil {
+			return
+		}
+	}
+	err = ctx.Err()
+	if err != nil {
+		return
+	}
+	dest.Clear()
+	if nRead == 0 {
+		return CheckErr(dest)
+	}
+	firstSlot, _, ok := TryAppendSlots(dest, IDX2(nRead))
+	if !ok {
+		return firstErr(CheckErr(dest), ErrNoFreeSlots)
+	}
+	destIdx := firstSlot
+	nWritten := 0
+	// `dest` has been cleared, so the merge is finished even if `ctx` is cancelled
+	err = mergeExternalSortRuns(context.WithoutCancel(ctx), runs, greaterThan, codec, memoryBudget, func(val T) error {
+		dest.Set(destIdx, val)
+		destIdx = dest.NextIdx(destIdx)
+		nWritten += 1
+		if nWritten%externalSortCheckInterval == 0 {
+			reportExternalSortProgress(ctx, ExternalSortWritingOutput, nWritten, nRead)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	reportExternalSortProgress(ctx, ExternalSortWritingOutput, nWritten, nRead)
+	return CheckErr(dest)
+}
+
+// Merge consecutive groups of at most `ExternalSortMaxFanIn` runs into single runs,
+// keeping the runs in source order
+func mergeExternalSortRunGroups[T any](ctx context.Context, runs []*externalSortRun[T], greaterThan func(a T, b T) bool, codec RecordCodec[T], memoryBudget int, tempDir string, total int) (newRuns []*externalSortRun[T], err error) {
+	nMerged := 0
+	for len(runs) > 0 {
+		group := runs[:min(ExternalSortMaxFanIn, len(runs))]
+		var merged *externalSortRun[T]
+		merged, err = newExternalSortRun(tempDir, codec, len(newRuns))
+		if err == nil {
+			newRuns = append(newRuns, merged)
+			err = mergeExternalSortRuns(ctx, group, greaterThan, codec, memoryBudget, func(val T) error {
+				nMerged += 1
+				if nMerged%externalSortCheckInterval == 0 {
+					reportExternalSortProgress(ctx, ExternalSortMergingRuns, nMerged, total)
+				}
+				return merged.write(val)
+			})
+		}
+		if err == nil {
+			err = merged.finishWriting()
+		}
+		for _, run := range group {
+			run.remove()
+		}
+		runs = runs[len(group):]
+		if err != nil {
+			// Keep the unmerged runs so the caller removes them
+			newRuns = append(newRuns, runs...)
+			return
+		}
+	}
+	reportExternalSortProgress(ctx, ExternalSortMergingRuns, nMerged, total)
+	return
+}
+
+// Perform a k-way merge of `runs`, calling `emit` with each value in sorted order
+func mergeExternalSortRuns[T any](ctx context.Context, runs []*externalSortRun[T], greaterThan func(a T, b T) bool, codec RecordCodec[T], memoryBudget int, emit func(val T) error) (err error) {
+	readBufSize := max(codec.Size(), memoryBudget/len(runs))
+	merger := &externalSortMerger[T]{
+		greaterThan: greaterThan,
+	}
+	for _, run := range runs {
+		var hasVal bool
+		hasVal, err = run.startReading(readBufSize)
+		if err != nil {
+			return
+		}
+		if hasVal {
+			merger.runs = append(merger.runs, run)
+		}
+	}
+	heap.Init(merger)
+	nEmitted := 0
+	for len(merger.runs) > 0 {
+		run := merger.runs[0]
+		err = emit(run.head)
+		if err != nil {
+			return
+		}
+		nEmitted += 1
+		if nEmitted%externalSortCheckInterval == 0 {
+			err = ctx.Err()
+			if err != nil {
+				return
+			}
+		}
+		var hasVal bool
+		hasVal, err = run.readNext()
+		if err != nil {
+			return
+		}
+		if hasVal {
+			heap.Fix(merger, 0)
+		} else {
+			heap.Pop(merger)
+		}
+	}
+	return ctx.Err()
+}
+
+// A sorted run of values stored in a temporary file
+type externalSortRun[T any] struct {
+	file   *os.File
+	writer *bufio.Writer
+	reader *bufio.Reader
+	codec  RecordCodec[T]
+	buf    []byte
+	head   T
+	// The position of this run in source order, used to keep the merge stable
+	order int
+}
+
+func newExternalSortRun[T any](tempDir string, codec RecordCodec[T], order int) (run *externalSortRun[T], err error) {
+	file, err := os.CreateTemp(tempDir, "go_list_like_sort_*")
+	if err != nil {
+		return nil, err
+	}
+	run = &externalSortRun[T]{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		codec:  codec,
+		buf:    make([]byte, codec.Size()),
+		order:  order,
+	}
+	return run, nil
+}
+
+func (run *externalSortRun[T]) write(val T) (err error) {
+	run.codec.Encode(val, run.buf)
+	_, err = run.writer.Write(run.buf)
+	return
+}
+
+func (run *externalSortRun[T]) finishWriting() (err error) {
+	err = run.writer.Flush()
+	run.writer = nil
+	return
+}
+
+// Rewind the run and read its first value
+func (run *externalSortRun[T]) startReading(bufSize int) (hasVal bool, err error) {
+	_, err = run.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return
+	}
+	run.reader = bufio.NewReaderSize(run.file, bufSize)
+	return run.readNext()
+}
+
+func (run *externalSortRun[T]) readNext() (hasVal bool, err error) {
+	_, err = io.ReadFull(run.reader, run.buf)
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	run.head = run.codec.Decode(run.buf)
+	return true, nil
+}
+
+func (run *externalSortRun[T]) remove() {
+	if run.file == nil {
+		return
+	}
+	run.file.Close()
+	os.Remove(run.file.Name())
+	run.file = nil
+}
+
+// A min-heap of runs ordered by their current head value, then by source order
+type externalSortMerger[T any] struct {
+	runs        []*externalSortRun[T]
+	greaterThan func(a T, b T) bool
+}
+
+func (m *externalSortMerger[T]) Len() int {
+	return len(m.runs)
+}
+func (m *externalSortMerger[T]) Less(i int, j int) bool {
+	a, b := m.runs[i], m.runs[j]
+	if m.greaterThan(b.head, a.head) {
+		return true
+	}
+	if m.greaterThan(a.head, b.head) {
+		return false
+	}
+	return a.order < b.order
+}
+func (m *externalSortMerger[T]) Swap(i int, j int) {
+	m.runs[i], m.runs[j] = m.runs[j], m.runs[i]
+}
+func (m *externalSortMerger[T]) Push(x any) {
+	m.runs = append(m.runs, x.(*externalSortRun[T]))
+}
+func (m *externalSortMerger[T]) Pop() any {
+	last := m.runs[len(m.runs)-1]
+	m.runs = m.runs[:len(m.runs)-1]
+	return last
+}
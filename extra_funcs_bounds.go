@@ -0,0 +1,155 @@
+package go_list_like
+
+import "cmp"
+
+// The bound functions search a slice sorted in ascending order using a single three-way comparison,
+// such as `cmp.Compare`, that returns a negative number when `a < b`, a positive number when `a > b`,
+// and zero when they are equal
+//
+// If `slice.PreferLinearOps() == true`, a `Cursor` gallops forward from the first index and then
+// bisects, so the search stops early when the target is near the start, otherwise a binary search
+// is performed. When no value satisfies the search, the returned index is the index after the
+// last index, which is not valid for the slice
+
+// Search the sorted slice with a comparison function that returns a negative number when `item`
+// belongs before the target, a positive number when it belongs after, and zero when it matches
+//
+// Returns the first index whose value does not belong before the target,
+// and whether the value at that index matches
+func SearchFunc[T any, IDX Integer, S SliceLike[T, IDX]](slice S, cmp func(item T) int) (idx IDX, found bool) {
+	idx, found = sorted_FirstIdxWhere(slice, slice.FirstIdx(), slice.LastIdx(), func(item T) bool {
+		return cmp(item) >= 0
+	})
+	if !found {
+		return
+	}
+	found = cmp(slice.Get(idx)) == 0
+	return
+}
+
+// Return the first index whose value is not less than `val`, and whether the value at that index equals `val`
+func LowerBound[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, val TT, compare func(a T, b TT) int) (idx IDX, found bool) {
+	return SearchFunc(slice, func(item T) int {
+		return compare(item, val)
+	})
+}
+func LowerBoundImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, val T) (idx IDX, found bool) {
+	return LowerBound(slice, val, cmp.Compare[T])
+}
+
+// Return the first index whose value is greater than `val`
+func UpperBound[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, val TT, compare func(a T, b TT) int) (idx IDX) {
+	idx, _ = sorted_FirstIdxWhere(slice, slice.FirstIdx(), slice.LastIdx(), func(item T) bool {
+		return compare(item, val) > 0
+	})
+	return
+}
+func UpperBoundImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, val T) (idx IDX) {
+	return UpperBound(slice, val, cmp.Compare[T])
+}
+
+// Return the first and last index (inclusive) of the values equal to `val`
+//
+// If no value equals `val`, `found == false`
+func EqualRange[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, val TT, compare func(a T, b TT) int) (firstIdx IDX, lastIdx IDX, found bool) {
+	firstIdx, found = LowerBound(slice, val, compare)
+	if !found {
+		return
+	}
+	afterIdx, foundAfter := sorted_FirstIdxWhere(slice, firstIdx, slice.LastIdx(), func(item T) bool {
+		return compare(item, val) > 0
+	})
+	if foundAfter {
+		lastIdx = slice.PrevIdx(afterIdx)
+	} else {
+		lastIdx = slice.LastIdx()
+	}
+	return
+}
+func EqualRangeImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, val T) (firstIdx IDX, lastIdx IDX, found bool) {
+	return EqualRange(slice, val, cmp.Compare[T])
+}
+
+// Return the number of values equal to `val`
+func CountEqual[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, val TT, compare func(a T, b TT) int) (count IDX) {
+	firstIdx, lastIdx, found := EqualRange(slice, val, compare)
+	if !found {
+		return 0
+	}
+	return slice.LenBetween(firstIdx, lastIdx)
+}
+func CountEqualImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, val T) (count IDX) {
+	return CountEqual(slice, val, cmp.Compare[T])
+}
+
+// Return the first index from `lo` to `hi` (inclusive) whose value satisfies `isAfter()`,
+// assuming every value that does not satisfy it comes before every value that does
+//
+// If no value satisfies it, `found == false` and `idx` is the index after `hi`
+func sorted_FirstIdxWhere[T any, IDX Integer, S SliceLike[T, IDX]](slice S, lo, hi IDX, isAfter func(item T) bool) (idx IDX, found bool) {
+	if !slice.IdxValid(lo) || !slice.IdxValid(hi) {
+		idx = lo
+		return
+	}
+	if slice.PreferLinearOps() {
+		return sorted_LinearFirstIdxWhere(slice, lo, hi, isAfter)
+	}
+	return sorted_BinaryFirstIdxWhere(slice, lo, hi, isAfter)
+}
+
+func sorted_BinaryFirstIdxWhere[T any, IDX Integer, S SliceLike[T, IDX]](slice S, lo, hi IDX, isAfter func(item T) bool) (idx IDX, found bool) {
+	if !isAfter(slice.Get(hi)) {
+		idx = slice.NextIdx(hi)
+		return
+	}
+	var mid IDX
+	for lo != hi {
+		mid = slice.SplitRange(lo, hi)
+		// Some implementations round the middle up, which would never shrink a range of two
+		if mid == hi {
+			mid = slice.PrevIdx(hi)
+		}
+		if isAfter(slice.Get(mid)) {
+			hi = mid
+		} else {
+			lo = slice.NextIdx(mid)
+		}
+	}
+	return hi, true
+}
+
+// Gallops forward from `lo` with a `Cursor`, testing positions 0, 1, 3, 7, 15, ... until one
+// satisfies `isAfter`, then bisects back over the last gap. If the returned index is K places
+// after `lo`, this walks O(K) indexes and tests O(log K) values, so it stops early when the
+// target is near `lo`
+func sorted_LinearFirstIdxWhere[T any, IDX Integer, S SliceLike[T, IDX]](slice S, lo, hi IDX, isAfter func(item T) bool) (idx IDX, found bool) {
+	cursor := NewCursorAt(slice, lo, 0)
+	var loPos IDX = 0
+	var step IDX = 1
+	for !isAfter(cursor.Get()) {
+		if cursor.Idx() == hi {
+			idx = slice.NextIdx(hi)
+			return
+		}
+		loPos = cursor.Pos() + 1
+		for n := IDX(0); n < step && cursor.Idx() != hi; n += 1 {
+			cursor.Next()
+		}
+		// Stop doubling before `step` overflows a small `IDX`
+		if step<<1 > step {
+			step <<= 1
+		}
+	}
+	hiPos := cursor.Pos()
+	for loPos != hiPos {
+		midPos := loPos + ((hiPos - loPos) >> 1)
+		cursor.Seek(midPos)
+		if isAfter(cursor.Get()) {
+			hiPos = midPos
+		} else {
+			loPos = midPos + 1
+		}
+	}
+	cursor.Seek(hiPos)
+	return cursor.Idx(), true
+}
@@ -0,0 +1,220 @@
+package go_list_like
+
+// The heap functions keep the values of a slice arranged as an implicit d-ary heap, where no value
+// is greater than any of its children according to `greaterThan`. The first value of the slice is
+// therefore always a value that no other value is less than
+//
+// Values are located by their position from the first index, so when `PreferLinearOps() == true`
+// every step of a sift walks the slice from its first index. Use an implementation with cheap
+// `NthNextIdx()` (such as `SliceAdapter` or `RingBuffer`) for large heaps
+//
+// To build a max-heap, pass a function that reports whether `a` is less than `b` as `greaterThan`
+
+// Arrange the values of the slice into a binary heap in O(n) time
+func HeapInit[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	HeapInitWithArity(slice, greaterThan, 2)
+}
+func HeapInitImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S) {
+	HeapInitWithArity(slice, GreaterThanImplicit, 2)
+}
+
+// Arrange the values of the slice into a heap where each value has up to `arity` children
+//
+// Assumes `arity >= 2`
+func HeapInitWithArity[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) {
+	n := slice.Len()
+	if n < 2 {
+		return
+	}
+	heap := newHeapSifter(slice, greaterThan, arity)
+	// Counts down to zero without going below it, so unsigned `IDX` types are supported
+	for pos := ((n - 2) / arity) + 1; pos > 0; {
+		pos -= 1
+		heap.siftDown(pos, n)
+	}
+}
+
+// Return the first value of the heap, which no other value is less than
+func HeapPeek[T any, IDX Integer, S SliceLike[T, IDX]](slice S) (val T) {
+	return slice.Get(slice.FirstIdx())
+}
+func TryHeapPeek[T any, IDX Integer, S SliceLike[T, IDX]](slice S) (val T, ok bool) {
+	first := slice.FirstIdx()
+	ok = slice.IdxValid(first)
+	if !ok {
+		return
+	}
+	val = slice.Get(first)
+	return
+}
+
+// Append `val` to the binary heap and move it into its place
+func HeapPush[T any, IDX Integer, L ListLike[T, IDX]](list L, val T, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	HeapPushWithArity(list, val, greaterThan, 2)
+}
+func TryHeapPush[T any, IDX Integer, L ListLike[T, IDX]](list L, val T, greaterThan func(a T, b T) (isGreaterThan bool)) (ok bool) {
+	return TryHeapPushWithArity(list, val, greaterThan, 2)
+}
+func HeapPushImplicit[T Ordered, IDX Integer, L ListLike[T, IDX]](list L, val T) {
+	HeapPushWithArity(list, val, GreaterThanImplicit, 2)
+}
+func HeapPushWithArity[T any, IDX Integer, L ListLike[T, IDX]](list L, val T, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) {
+	AppendVar(list, val)
+	newHeapSifter(list, greaterThan, arity).siftUp(list.Len() - 1)
+}
+func TryHeapPushWithArity[T any, IDX Integer, L ListLike[T, IDX]](list L, val T, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) (ok bool) {
+	ok = list.TryEnsureFreeSlots(1)
+	if !ok {
+		return
+	}
+	HeapPushWithArity(list, val, greaterThan, arity)
+	return
+}
+
+// Remove and return the first value of the binary heap, which no other value is less than
+func HeapPop[T any, IDX Integer, L ListLike[T, IDX]](list L, greaterThan func(a T, b T) (isGreaterThan bool)) (val T) {
+	return HeapPopWithArity(list, greaterThan, 2)
+}
+func TryHeapPop[T any, IDX Integer, L ListLike[T, IDX]](list L, greaterThan func(a T, b T) (isGreaterThan bool)) (val T, ok bool) {
+	return TryHeapPopWithArity(list, greaterThan, 2)
+}
+func HeapPopImplicit[T Ordered, IDX Integer, L ListLike[T, IDX]](list L) (val T) {
+	return HeapPopWithArity(list, GreaterThanImplicit, 2)
+}
+func HeapPopWithArity[T any, IDX Integer, L ListLike[T, IDX]](list L, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) (val T) {
+	return HeapRemoveWithArity(list, list.FirstIdx(), greaterThan, arity)
+}
+func TryHeapPopWithArity[T any, IDX Integer, L ListLike[T, IDX]](list L, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) (val T, ok bool) {
+	first := list.FirstIdx()
+	ok = list.IdxValid(first)
+	if !ok {
+		return
+	}
+	val = HeapRemoveWithArity(list, first, greaterThan, arity)
+	return
+}
+
+// Move the value at `idx` into its place after it has been changed
+func HeapFix[T any, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	HeapFixWithArity(slice, idx, greaterThan, 2)
+}
+func HeapFixImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX) {
+	HeapFixWithArity(slice, idx, GreaterThanImplicit, 2)
+}
+func HeapFixWithArity[T any, IDX Integer, S SliceLike[T, IDX]](slice S, idx IDX, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) {
+	heap := newHeapSifter(slice, greaterThan, arity)
+	heap.fix(slice.LenBetween(heap.first, idx)-1, slice.Len())
+}
+
+// Remove and return the value at `idx`, moving the last value of the heap into its place
+func HeapRemove[T any, IDX Integer, L ListLike[T, IDX]](list L, idx IDX, greaterThan func(a T, b T) (isGreaterThan bool)) (val T) {
+	return HeapRemoveWithArity(list, idx, greaterThan, 2)
+}
+func TryHeapRemove[T any, IDX Integer, L ListLike[T, IDX]](list L, idx IDX, greaterThan func(a T, b T) (isGreaterThan bool)) (val T, ok bool) {
+	ok = list.IdxValid(idx)
+	if !ok {
+		return
+	}
+	val = HeapRemoveWithArity(list, idx, greaterThan, 2)
+	return
+}
+func HeapRemoveImplicit[T Ordered, IDX Integer, L ListLike[T, IDX]](list L, idx IDX) (val T) {
+	return HeapRemoveWithArity(list, idx, GreaterThanImplicit, 2)
+}
+func HeapRemoveWithArity[T any, IDX Integer, L ListLike[T, IDX]](list L, idx IDX, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) (val T) {
+	val = list.Get(idx)
+	last := list.LastIdx()
+	if idx == last {
+		list.DeleteRange(last, last)
+		return
+	}
+	pos := list.LenBetween(list.FirstIdx(), idx) - 1
+	list.Set(idx, list.Get(last))
+	list.DeleteRange(last, last)
+	newHeapSifter(list, greaterThan, arity).fix(pos, list.Len())
+	return
+}
+
+type heapSifter[T any, IDX Integer, S SliceLike[T, IDX]] struct {
+	slice       S
+	first       IDX
+	arity       IDX
+	greaterThan func(a T, b T) (isGreaterThan bool)
+}
+
+func newHeapSifter[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) (isGreaterThan bool), arity IDX) heapSifter[T, IDX, S] {
+	return heapSifter[T, IDX, S]{
+		slice:       slice,
+		first:       slice.FirstIdx(),
+		arity:       arity,
+		greaterThan: greaterThan,
+	}
+}
+
+func (h heapSifter[T, IDX, S]) idx(pos IDX) IDX {
+	if pos == 0 {
+		return h.first
+	}
+	return h.slice.NthNextIdx(h.first, pos)
+}
+
+// Move the value at `pos` up or down the first `n` values of the heap into its place
+func (h heapSifter[T, IDX, S]) fix(pos IDX, n IDX) {
+	if !h.siftUp(pos) {
+		h.siftDown(pos, n)
+	}
+}
+
+// Move the value at `pos` toward the root while its parent is greater than it
+func (h heapSifter[T, IDX, S]) siftUp(pos IDX) (moved bool) {
+	idx := h.idx(pos)
+	val := h.slice.Get(idx)
+	for pos > 0 {
+		parentPos := (pos - 1) / h.arity
+		parentIdx := h.idx(parentPos)
+		parentVal := h.slice.Get(parentIdx)
+		if !h.greaterThan(parentVal, val) {
+			break
+		}
+		h.slice.Set(idx, parentVal)
+		pos, idx = parentPos, parentIdx
+		moved = true
+	}
+	if moved {
+		h.slice.Set(idx, val)
+	}
+	return
+}
+
+// Move the value at `pos` away from the root while it is greater than
+// its least child among the first `n` values of the heap
+func (h heapSifter[T, IDX, S]) siftDown(pos IDX, n IDX) (moved bool) {
+	idx := h.idx(pos)
+	val := h.slice.Get(idx)
+	// Comparing against `(n - 2) / arity` avoids overflowing `IDX` when finding the first child
+	for n >= 2 && pos <= (n-2)/h.arity {
+		childPos := (pos * h.arity) + 1
+		lastChildPos := childPos + min(h.arity-1, n-1-childPos)
+		// Siblings are adjacent, so only the first child needs to be located from the start
+		childIdx := h.idx(childPos)
+		leastPos, leastIdx, leastVal := childPos, childIdx, h.slice.Get(childIdx)
+		for childPos < lastChildPos {
+			childPos += 1
+			childIdx = h.slice.NextIdx(childIdx)
+			childVal := h.slice.Get(childIdx)
+			if h.greaterThan(leastVal, childVal) {
+				leastPos, leastIdx, leastVal = childPos, childIdx, childVal
+			}
+		}
+		if !h.greaterThan(val, leastVal) {
+			break
+		}
+		h.slice.Set(idx, leastVal)
+		pos, idx = leastPos, leastIdx
+		moved = true
+	}
+	if moved {
+		h.slice.Set(idx, val)
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package go_list_like
+
+// The rearranging functions move values between indexes with `Get()` and `Set()` while only walking
+// forward with `NextIdx()`, so they work on any implementation, including ones whose indexes are
+// not consecutive. Indexes keep their place in the slice and the values are moved between them
+
+// Rotate the values from `firstIdx` to `lastIdx` (inclusive) so that the value at `middleIdx` is moved
+// to `firstIdx`, the values before it follow the value at `lastIdx`, and the order is otherwise kept.
+// Returns the index the value at `firstIdx` was moved to
+//
+// Assumes `RangeValid(firstIdx, middleIdx) == true` and `RangeValid(middleIdx, lastIdx) == true`
+func Rotate[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, middleIdx IDX, lastIdx IDX) (newIdxOfFirst IDX) {
+	newIdxOfFirst = firstIdx
+	found := false
+	var writeIdx, readIdx, nextReadIdx IDX
+	// Each round moves the values starting at `middleIdx` into place from `firstIdx`, leaving a
+	// smaller rotation of the values that were displaced
+	for firstIdx != middleIdx {
+		writeIdx = firstIdx
+		nextReadIdx = firstIdx
+		readIdx = middleIdx
+		for {
+			if writeIdx == nextReadIdx {
+				nextReadIdx = readIdx
+			}
+			Swap(slice, writeIdx, readIdx)
+			writeIdx = slice.NextIdx(writeIdx)
+			if readIdx == lastIdx {
+				break
+			}
+			readIdx = slice.NextIdx(readIdx)
+		}
+		if !found {
+			newIdxOfFirst = writeIdx
+			found = true
+		}
+		firstIdx = writeIdx
+		middleIdx = nextReadIdx
+	}
+	return
+}
+func TryRotate[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, middleIdx IDX, lastIdx IDX) (newIdxOfFirst IDX, ok bool) {
+	ok = slice.RangeValid(firstIdx, middleIdx) && slice.RangeValid(middleIdx, lastIdx)
+	if !ok {
+		return
+	}
+	newIdxOfFirst = Rotate(slice, firstIdx, middleIdx, lastIdx)
+	return
+}
+
+// Rearrange the slice so that every value where `inFirstGroup()` returns true comes before
+// every value where it returns false, in O(n) time. The order within each group is not kept
+//
+// Returns the index of the first value of the second group, which is not valid if every
+// value is in the first group
+func Partition[T any, IDX Integer, S SliceLike[T, IDX]](slice S, inFirstGroup func(item T) bool) (secondGroupIdx IDX) {
+	secondGroupIdx = slice.FirstIdx()
+	for slice.IdxValid(secondGroupIdx) && inFirstGroup(slice.Get(secondGroupIdx)) {
+		secondGroupIdx = slice.NextIdx(secondGroupIdx)
+	}
+	if !slice.IdxValid(secondGroupIdx) {
+		return
+	}
+	idx := slice.NextIdx(secondGroupIdx)
+	for slice.IdxValid(idx) {
+		if inFirstGroup(slice.Get(idx)) {
+			Swap(slice, secondGroupIdx, idx)
+			secondGroupIdx = slice.NextIdx(secondGroupIdx)
+		}
+		idx = slice.NextIdx(idx)
+	}
+	return
+}
+
+// Identical to `Partition()`, but keeps the order of the values within each group
+//
+// Partitions halves of the slice and joins them with `Rotate()`, using O(n log n) `Get()`
+// and `Set()` calls and no extra memory
+func StablePartition[T any, IDX Integer, S SliceLike[T, IDX]](slice S, inFirstGroup func(item T) bool) (secondGroupIdx IDX) {
+	first := slice.FirstIdx()
+	n := slice.Len()
+	if n == 0 {
+		return first
+	}
+	nFirstGroup, _ := stablePartition_internal(slice, first, n, inFirstGroup)
+	return slice.NthNextIdx(first, nFirstGroup)
+}
+
+// Stable partition the `n` values starting at `firstIdx`, returning how many are in the
+// first group and the index after the last of them
+func stablePartition_internal[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, n IDX, inFirstGroup func(item T) bool) (nFirstGroup IDX, afterIdx IDX) {
+	if n == 1 {
+		if inFirstGroup(slice.Get(firstIdx)) {
+			nFirstGroup = 1
+		}
+		return nFirstGroup, slice.NextIdx(firstIdx)
+	}
+	half := n / 2
+	nLeft, middleIdx := stablePartition_internal(slice, firstIdx, half, inFirstGroup)
+	nRight, afterIdx := stablePartition_internal(slice, middleIdx, n-half, inFirstGroup)
+	// Swap the second group of the left half with the first group of the right half
+	if nLeft < half && nRight > 0 {
+		Rotate(slice, slice.NthNextIdx(firstIdx, nLeft), middleIdx, slice.NthNextIdx(middleIdx, nRight-1))
+	}
+	return nLeft + nRight, afterIdx
+}
+
+// Remove every value where `shouldRemove()` returns true, keeping the order of the remaining values
+//
+// The remaining values are moved forward in a single pass, then the leftover values at the
+// end of the list are removed with a single `DeleteRange()`. Returns the number of values removed
+func RemoveIf[T any, IDX Integer, L ListLike[T, IDX]](list L, shouldRemove func(item T) bool) (nRemoved IDX) {
+	writeIdx := list.FirstIdx()
+	readIdx := writeIdx
+	var val T
+	for list.IdxValid(readIdx) {
+		val = list.Get(readIdx)
+		if shouldRemove(val) {
+			nRemoved += 1
+		} else {
+			if writeIdx != readIdx {
+				list.Set(writeIdx, val)
+			}
+			writeIdx = list.NextIdx(writeIdx)
+		}
+		readIdx = list.NextIdx(readIdx)
+	}
+	if nRemoved > 0 {
+		list.DeleteRange(writeIdx, list.LastIdx())
+	}
+	return
+}
+
+// Remove every value where `shouldKeep()` returns false, keeping the order of the remaining values
+//
+// See `RemoveIf()`
+func RetainIf[T any, IDX Integer, L ListLike[T, IDX]](list L, shouldKeep func(item T) bool) (nRemoved IDX) {
+	return RemoveIf(list, func(item T) bool {
+		return !shouldKeep(item)
+	})
+}
@@ -0,0 +1,351 @@
+package go_list_like
+
+import "math/bits"
+
+// Adapted from the pattern-defeating quicksort (pdqsort) in the Go standard library `sort` package,
+// which is itself based on https://arxiv.org/pdf/2106.05123.pdf
+//
+// The algorithm works on positions `0 <= pos < n` relative to the first index of the slice,
+// and only touches the slice through `NthNextIdx()`, `Get()`, and `Set()`, so it works
+// for any random-access implementation regardless of how its indexes are laid out
+
+type pdqSortHint int
+
+const (
+	pdqUnknownHint pdqSortHint = iota
+	pdqIncreasingHint
+	pdqDecreasingHint
+)
+
+type pdqSorter[T any, IDX Integer, S SliceLike[T, IDX]] struct {
+	slice       S
+	first       IDX
+	greaterThan func(a T, b T) bool
+}
+
+func (s *pdqSorter[T, IDX, S]) idx(pos int) IDX {
+	return s.slice.NthNextIdx(s.first, IDX(pos))
+}
+
+// Return whether the value at position `i` sorts before the value at position `j`
+func (s *pdqSorter[T, IDX, S]) less(i int, j int) bool {
+	return s.greaterThan(s.slice.Get(s.idx(j)), s.slice.Get(s.idx(i)))
+}
+
+func (s *pdqSorter[T, IDX, S]) swap(i int, j int) {
+	idxI := s.idx(i)
+	idxJ := s.idx(j)
+	valI := s.slice.Get(idxI)
+	s.slice.Set(idxI, s.slice.Get(idxJ))
+	s.slice.Set(idxJ, valI)
+}
+
+func (s *pdqSorter[T, IDX, S]) insertionSort(a int, b int) {
+	for i := a + 1; i < b; i += 1 {
+		for j := i; j > a && s.less(j, j-1); j -= 1 {
+			s.swap(j, j-1)
+		}
+	}
+}
+
+// Restore the heap property on positions `lo` to `hi` (exclusive),
+// where `first` is the position of the root of the heap
+func (s *pdqSorter[T, IDX, S]) siftDown(lo int, hi int, first int) {
+	root := lo
+	for {
+		child := 2*root + 1
+		if child >= hi {
+			return
+		}
+		if child+1 < hi && s.less(first+child, first+child+1) {
+			child += 1
+		}
+		if !s.less(first+root, first+child) {
+			return
+		}
+		s.swap(first+root, first+child)
+		root = child
+	}
+}
+
+func (s *pdqSorter[T, IDX, S]) heapSort(a int, b int) {
+	first := a
+	hi := b - a
+	for i := (hi - 1) / 2; i >= 0; i -= 1 {
+		s.siftDown(i, hi, first)
+	}
+	for i := hi - 1; i >= 0; i -= 1 {
+		s.swap(first, first+i)
+		s.siftDown(0, i, first)
+	}
+}
+
+// Sort positions `a` to `b` (exclusive), falling back to heap sort
+// after `limit` badly unbalanced partitions
+func (s *pdqSorter[T, IDX, S]) pdqsort(a int, b int, limit int) {
+	const maxInsertion = 12
+	wasBalanced := true
+	wasPartitioned := true
+	for {
+		length := b - a
+		if length <= maxInsertion {
+			s.insertionSort(a, b)
+			return
+		}
+		if limit == 0 {
+			s.heapSort(a, b)
+			return
+		}
+		if !wasBalanced {
+			s.breakPatterns(a, b)
+			limit -= 1
+		}
+		pivot, hint := s.choosePivot(a, b)
+		if hint == pdqDecreasingHint {
+			s.reverseRange(a, b)
+			pivot = (b - 1) - (pivot - a)
+			hint = pdqIncreasingHint
+		}
+		if wasBalanced && wasPartitioned && hint == pdqIncreasingHint {
+			if s.partialInsertionSort(a, b) {
+				return
+			}
+		}
+		// Everything before `a` is known to be less than or equal to everything
+		// in range, so if the pivot equals it the range is full of duplicates
+		if a > 0 && !s.less(a-1, pivot) {
+			a = s.partitionEqual(a, b, pivot)
+			continue
+		}
+		mid, alreadyPartitioned := s.partition(a, b, pivot)
+		wasPartitioned = alreadyPartitioned
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			s.pdqsort(a, mid, limit)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			s.pdqsort(mid+1, b, limit)
+			b = mid
+		}
+	}
+}
+
+// Partition positions `a` to `b` (exclusive) around the value at `pivot`,
+// returning the pivot's new position
+func (s *pdqSorter[T, IDX, S]) partition(a int, b int, pivot int) (newPivot int, alreadyPartitioned bool) {
+	s.swap(a, pivot)
+	i, j := a+1, b-1
+	for i <= j && s.less(i, a) {
+		i += 1
+	}
+	for i <= j && !s.less(j, a) {
+		j -= 1
+	}
+	if i > j {
+		s.swap(j, a)
+		return j, true
+	}
+	s.swap(i, j)
+	i += 1
+	j -= 1
+	for {
+		for i <= j && s.less(i, a) {
+			i += 1
+		}
+		for i <= j && !s.less(j, a) {
+			j -= 1
+		}
+		if i > j {
+			break
+		}
+		s.swap(i, j)
+		i += 1
+		j -= 1
+	}
+	s.swap(j, a)
+	return j, false
+}
+
+// Partition positions `a` to `b` (exclusive) into values equal to the value at `pivot`
+// followed by values greater than it, assuming no values are less than it
+func (s *pdqSorter[T, IDX, S]) partitionEqual(a int, b int, pivot int) (newPivot int) {
+	s.swap(a, pivot)
+	i, j := a+1, b-1
+	for {
+		for i <= j && !s.less(a, i) {
+			i += 1
+		}
+		for i <= j && s.less(a, j) {
+			j -= 1
+		}
+		if i > j {
+			break
+		}
+		s.swap(i, j)
+		i += 1
+		j -= 1
+	}
+	return i
+}
+
+// Try to finish sorting a nearly sorted range by shifting a few out-of-order values,
+// returning whether the range is now sorted
+func (s *pdqSorter[T, IDX, S]) partialInsertionSort(a int, b int) bool {
+	const (
+		maxSteps         = 5
+		shortestShifting = 50
+	)
+	i := a + 1
+	for step := 0; step < maxSteps; step += 1 {
+		for i < b && !s.less(i, i-1) {
+			i += 1
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+		s.swap(i, i-1)
+		if i-a >= 2 {
+			for j := i - 1; j > a; j -= 1 {
+				if !s.less(j, j-1) {
+					break
+				}
+				s.swap(j, j-1)
+			}
+		}
+		if b-i >= 2 {
+			for j := i + 1; j < b; j += 1 {
+				if !s.less(j, j-1) {
+					break
+				}
+				s.swap(j, j-1)
+			}
+		}
+	}
+	return false
+}
+
+// Scatter a few values around the middle of the range to break up
+// patterns that cause unbalanced partitions
+func (s *pdqSorter[T, IDX, S]) breakPatterns(a int, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+	random := uint64(length)
+	modulus := uint(1) << bits.Len(uint(length))
+	for pos := a + (length/4)*2 - 1; pos <= a+(length/4)*2+1; pos += 1 {
+		random ^= random << 13
+		random ^= random >> 7
+		random ^= random << 17
+		other := int(uint(random) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		s.swap(pos, a+other)
+	}
+}
+
+// Choose a pivot position using a static pivot for short ranges, median-of-three for
+// medium ranges, and Tukey's ninther for long ranges, along with a hint of whether
+// the sampled values were already in increasing or decreasing order
+func (s *pdqSorter[T, IDX, S]) choosePivot(a int, b int) (pivot int, hint pdqSortHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+	l := b - a
+	swaps := 0
+	i := a + l/4*1
+	j := a + l/4*2
+	k := a + l/4*3
+	if l >= 8 {
+		if l >= shortestNinther {
+			i = s.median(i-1, i, i+1, &swaps)
+			j = s.median(j-1, j, j+1, &swaps)
+			k = s.median(k-1, k, k+1, &swaps)
+		}
+		j = s.median(i, j, k, &swaps)
+	}
+	switch swaps {
+	case 0:
+		return j, pdqIncreasingHint
+	case maxSwaps:
+		return j, pdqDecreasingHint
+	default:
+		return j, pdqUnknownHint
+	}
+}
+
+func (s *pdqSorter[T, IDX, S]) order2(a int, b int, swaps *int) (int, int) {
+	if s.less(b, a) {
+		*swaps += 1
+		return b, a
+	}
+	return a, b
+}
+
+func (s *pdqSorter[T, IDX, S]) median(a int, b int, c int, swaps *int) int {
+	a, b = s.order2(a, b, swaps)
+	b, c = s.order2(b, c, swaps)
+	_, b = s.order2(a, b, swaps)
+	return b
+}
+
+func (s *pdqSorter[T, IDX, S]) reverseRange(a int, b int) {
+	i := a
+	j := b - 1
+	for i < j {
+		s.swap(i, j)
+		i += 1
+		j -= 1
+	}
+}
+
+// Sort every value in the slice by copying them out in index order, performing a
+// bottom-up merge sort on the copy, then writing them back in index order
+//
+// The slice is only ever walked with `NextIdx()`, making this suitable
+// for implementations that prefer linear operations
+func linearMergeSort[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) bool) {
+	var vals []T
+	DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+		vals = append(vals, item)
+	})
+	n := len(vals)
+	if n < 2 {
+		return
+	}
+	scratch := make([]T, n)
+	src, dest := vals, scratch
+	for width := 1; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid := min(lo+width, n)
+			hi := min(lo+2*width, n)
+			i, j, k := lo, mid, lo
+			for i < mid && j < hi {
+				if greaterThan(src[i], src[j]) {
+					dest[k] = src[j]
+					j += 1
+				} else {
+					dest[k] = src[i]
+					i += 1
+				}
+				k += 1
+			}
+			k += copy(dest[k:], src[i:mid])
+			copy(dest[k:], src[j:hi])
+		}
+		src, dest = dest, src
+	}
+	pos := 0
+	DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+		slice.Set(idx, src[pos])
+		pos += 1
+	})
+}
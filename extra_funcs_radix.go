@@ -0,0 +1,221 @@
+package go_list_like
+
+import (
+	"math"
+	"unsafe"
+)
+
+// The radix sort functions order values by the bytes of a numeric or string key instead of comparing
+// them, taking O(n * w) time where `w` is the width of the key in bytes. The sort is stable
+//
+// Each pass distributes every value between the slice and `scratch`, which is resized to hold
+// `Len()` values. If both the slice and `scratch` expose their values as a golang slice (see
+// `GoSliceLike` and `ContiguousMemSliceLike`), each pass works directly on that memory. Otherwise
+// each pass is made with `Get()` and `Set()`, walking the slice and `scratch` in index order when
+// they prefer linear operations, so any implementation is supported. Passes where every value
+// has the same byte are skipped
+//
+// Integer keys are ordered by value, including negative values. Float keys are ordered by value
+// with `-0` before `+0`, and every NaN before all other values (as `cmp.Compare()` orders them)
+
+// Sort the slice of numbers in ascending order
+func RadixSort[T Number, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, scratch L) {
+	RadixSortByKey(slice, scratch, func(val T) T {
+		return val
+	})
+}
+
+// Sort the slice in ascending order of the number `key()` returns for each value
+func RadixSortByKey[T any, K Number, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, scratch L, key func(val T) K) {
+	keyBits, nBytes := radix_KeyBits[K]()
+	radix_Sort(slice, scratch, 256, nBytes, func(val T, pass int) int {
+		return int((keyBits(key(val)) >> (pass * 8)) & 0xFF)
+	})
+}
+
+// Sort the slice of strings in ascending byte-wise order (as `<` orders them)
+//
+// Makes one pass for every byte of the longest string
+func RadixSortString[T ~string, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, scratch L) {
+	maxLen := 0
+	DoActionOnAllItems(slice, func(slice S, idx IDX1, item T) {
+		maxLen = max(maxLen, len(item))
+	})
+	// Strings that end before a byte position sort before every string that has a byte there
+	radix_Sort(slice, scratch, 257, maxLen, func(val T, pass int) int {
+		pos := maxLen - 1 - pass
+		if pos >= len(val) {
+			return 0
+		}
+		return int(val[pos]) + 1
+	})
+}
+
+// Return a function that maps a number to an unsigned integer in the same order,
+// along with the number of low bytes of the result that can differ
+func radix_KeyBits[K Number]() (keyBits func(val K) uint64, nBytes int) {
+	var zero K
+	nBytes = int(unsafe.Sizeof(zero))
+	half := K(1)
+	half /= 2
+	isFloat := half != 0
+	isSigned := zero-1 < zero
+	switch {
+	case isFloat && nBytes == 4:
+		keyBits = func(val K) uint64 {
+			if val != val {
+				return 0
+			}
+			bits := math.Float32bits(float32(val))
+			if bits&(1<<31) != 0 {
+				return uint64(^bits)
+			}
+			return uint64(bits | (1 << 31))
+		}
+	case isFloat:
+		keyBits = func(val K) uint64 {
+			if val != val {
+				return 0
+			}
+			bits := math.Float64bits(float64(val))
+			if bits&(1<<63) != 0 {
+				return ^bits
+			}
+			return bits | (1 << 63)
+		}
+	case isSigned:
+		signBit := uint64(1) << (nBytes*8 - 1)
+		keyBits = func(val K) uint64 {
+			return uint64(int64(val)) ^ signBit
+		}
+	default:
+		keyBits = func(val K) uint64 {
+			return uint64(val)
+		}
+	}
+	return
+}
+
+// Perform `nPasses` stable distributions of the slice's values into `nBuckets` buckets,
+// where `bucketOf()` returns the bucket of a value for a pass
+func radix_Sort[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, scratch L, nBuckets int, nPasses int, bucketOf func(val T, pass int) int) {
+	n := int(slice.Len())
+	if n < 2 || nPasses == 0 {
+		return
+	}
+	if int(scratch.Len()) != n {
+		scratch.Clear()
+		AppendSlots(scratch, IDX2(n))
+	}
+	counts := make([]int, nBuckets)
+	inScratch := false
+	sliceSpan, sliceOk := radix_Span(slice, n)
+	scratchSpan, scratchOk := radix_Span(scratch, n)
+	if sliceOk && scratchOk {
+		src, dest := sliceSpan, scratchSpan
+		for pass := range nPasses {
+			if radix_SpanPass(src, dest, counts, pass, bucketOf) {
+				src, dest = dest, src
+				inScratch = !inScratch
+			}
+		}
+		if inScratch {
+			copy(sliceSpan, scratchSpan)
+		}
+		return
+	}
+	var moved bool
+	for pass := range nPasses {
+		if inScratch {
+			moved = radix_Pass(scratch, slice, counts, pass, bucketOf)
+		} else {
+			moved = radix_Pass(slice, scratch, counts, pass, bucketOf)
+		}
+		if moved {
+			inScratch = !inScratch
+		}
+	}
+	if inScratch {
+		Copy(scratch, slice)
+	}
+}
+
+// Return all `n` values of the slice as a golang slice that shares memory with it, if possible
+func radix_Span[T any, IDX Integer, S SliceLike[T, IDX]](slice S, n int) (span []T, ok bool) {
+	first := slice.FirstIdx()
+	spanLen, _, ok := consecutiveRangeLen(slice, first, slice.LastIdx())
+	if !ok || spanLen != n {
+		return nil, false
+	}
+	return memSpan(slice, first, n)
+}
+
+// Turn the number of values in each bucket into the position of each bucket's first value
+//
+// Returns false if every value is in the same bucket, in which case the pass can be skipped
+func radix_Offsets(counts []int, n int) (shouldMove bool) {
+	offset := 0
+	for bucket, count := range counts {
+		if count == n {
+			return false
+		}
+		counts[bucket] = offset
+		offset += count
+	}
+	return true
+}
+
+func radix_SpanPass[T any](src []T, dest []T, counts []int, pass int, bucketOf func(val T, pass int) int) (moved bool) {
+	clear(counts)
+	for _, val := range src {
+		counts[bucketOf(val, pass)] += 1
+	}
+	if !radix_Offsets(counts, len(src)) {
+		return false
+	}
+	var bucket int
+	for _, val := range src {
+		bucket = bucketOf(val, pass)
+		dest[counts[bucket]] = val
+		counts[bucket] += 1
+	}
+	return true
+}
+
+func radix_Pass[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](src S1, dest S2, counts []int, pass int, bucketOf func(val T, pass int) int) (moved bool) {
+	clear(counts)
+	DoActionOnAllItems(src, func(src S1, idx IDX1, item T) {
+		counts[bucketOf(item, pass)] += 1
+	})
+	n := int(src.Len())
+	if !radix_Offsets(counts, n) {
+		return false
+	}
+	// The index the next value of each bucket is written to
+	nextIdx := make([]IDX2, len(counts))
+	if dest.PreferLinearOps() {
+		bucket := 0
+		pos := 0
+		DoActionOnAllItems(dest, func(dest S2, idx IDX2, item T) {
+			for bucket < len(counts) && counts[bucket] == pos {
+				nextIdx[bucket] = idx
+				bucket += 1
+			}
+			pos += 1
+		})
+	} else {
+		destFirst := dest.FirstIdx()
+		for bucket, offset := range counts {
+			if offset < n {
+				nextIdx[bucket] = dest.NthNextIdx(destFirst, IDX2(offset))
+			}
+		}
+	}
+	var bucket int
+	DoActionOnAllItems(src, func(src S1, idx IDX1, item T) {
+		bucket = bucketOf(item, pass)
+		dest.Set(nextIdx[bucket], item)
+		nextIdx[bucket] = dest.NextIdx(nextIdx[bucket])
+	})
+	return true
+}
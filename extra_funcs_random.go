@@ -0,0 +1,135 @@
+package go_list_like
+
+import (
+	"math/rand/v2"
+	"sort"
+)
+
+// The random functions draw every random number from the provided `rand.Source`, so passing
+// a source with a fixed seed (such as `rand.NewPCG()`) reproduces the same results
+
+// Put the values of the slice in a uniformly random order using the Fisher-Yates shuffle
+//
+// If `slice.PreferLinearOps() == true`, the slice's indexes are collected before shuffling so that
+// linked implementations are not repeatedly walked
+func Shuffle[T any, IDX Integer, S SliceLike[T, IDX]](slice S, src rand.Source) {
+	n := int(slice.Len())
+	if n < 2 {
+		return
+	}
+	rng := rand.New(src)
+	first := slice.FirstIdx()
+	idxAt := func(pos int) IDX {
+		return slice.NthNextIdx(first, IDX(pos))
+	}
+	if slice.PreferLinearOps() {
+		idxs := make([]IDX, 0, n)
+		DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+			idxs = append(idxs, idx)
+		})
+		idxAt = func(pos int) IDX {
+			return idxs[pos]
+		}
+	}
+	var other int
+	for pos := n - 1; pos > 0; pos -= 1 {
+		other = rng.IntN(pos + 1)
+		if other != pos {
+			Swap(slice, idxAt(pos), idxAt(other))
+		}
+	}
+}
+
+// Append `k` values chosen uniformly at random from `source` to `dest`, where each value is chosen
+// at most once. The chosen values keep the order they have in `source`
+//
+// `source` is walked once in index order (Knuth's selection sampling). If `k` is not less than
+// `source.Len()`, every value is appended. Returns the number of values appended
+func SampleWithoutReplacement[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](source S, k IDX2, dest L, src rand.Source) (nSampled IDX2) {
+	rng := rand.New(src)
+	nLeft := uint64(source.Len())
+	DoActionOnItemsUntilFalse(source, func(source S, idx IDX1, item T) bool {
+		if nSampled == k {
+			return false
+		}
+		// Choose this value with probability (values still needed) / (values left)
+		if rng.Uint64N(nLeft) < uint64(k-nSampled) {
+			AppendVar(dest, item)
+			nSampled += 1
+		}
+		nLeft -= 1
+		return true
+	})
+	return
+}
+
+// Consume every value in `queue`, keeping a uniformly random sample of up to `k` of all the
+// values seen in `dest` (Vitter's algorithm R)
+//
+// `nSeen` is the number of values seen by earlier calls with the same `dest`, and the new total
+// is returned, so `ReservoirSample()` can be called again as more values are queued. `dest` should
+// start empty with `nSeen == 0`, and only be changed by `ReservoirSample()` until the stream ends
+//
+// A chosen value replaces a random value of `dest` found with `NthNextIdx()`, so `dest`
+// should have cheap random access when `k` is large
+func ReservoirSample[T any, IDX1 Integer, IDX2 Integer, Q QueueLike[T, IDX1], L ListLike[T, IDX2]](queue Q, dest L, k IDX2, nSeen uint64, src rand.Source) (newNSeen uint64) {
+	rng := rand.New(src)
+	var replacePos uint64
+	DoActionOnAllItems(queue, func(queue Q, idx IDX1, item T) {
+		nSeen += 1
+		if dest.Len() < k {
+			AppendVar(dest, item)
+			return
+		}
+		replacePos = rng.Uint64N(nSeen)
+		if replacePos < uint64(k) {
+			dest.Set(dest.NthNextIdx(dest.FirstIdx(), IDX2(replacePos)), item)
+		}
+	})
+	queue.IncrementStart(queue.Len())
+	return nSeen
+}
+
+// Append `k` values chosen at random from `source` to `dest`, where each value is chosen with
+// probability proportional to its `weight()`. A value may be chosen more than once
+//
+// A table of the prefix sums of the weights is built in one walk of `source`, and each value
+// is then chosen with a binary search of the table, taking O(n + k log n) time and O(n) memory.
+// Values with a weight that is not positive are never chosen. Returns the number of values
+// appended, which is zero if no value has a positive weight
+func WeightedSample[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](source S, weight func(item T) float64, k IDX2, dest L, src rand.Source) (nSampled IDX2) {
+	n := int(source.Len())
+	prefixSums := make([]float64, 0, n)
+	idxs := make([]IDX1, 0, n)
+	total := 0.0
+	DoActionOnAllItems(source, func(source S, idx IDX1, item T) {
+		if w := weight(item); w > 0 {
+			total += w
+		}
+		prefixSums = append(prefixSums, total)
+		idxs = append(idxs, idx)
+	})
+	if total <= 0 {
+		return
+	}
+	rng := rand.New(src)
+	var target float64
+	var pos int
+	for nSampled < k {
+		target = rng.Float64() * total
+		// The first value whose range of the total contains `target`
+		pos = sort.Search(n, func(i int) bool {
+			return prefixSums[i] > target
+		})
+		// Rounding can leave `target` at the total
+		if pos == n {
+			pos = n - 1
+			for pos > 0 && prefixSums[pos] == prefixSums[pos-1] {
+				pos -= 1
+			}
+		}
+		AppendVar(dest, source.Get(idxs[pos]))
+		nSampled += 1
+	}
+	return
+}
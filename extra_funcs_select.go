@@ -0,0 +1,236 @@
+package go_list_like
+
+import "math/bits"
+
+// The selection functions order only as much of a slice as is needed to find its smallest values
+// according to `greaterThan`, which is much faster than a full sort when only a few values are wanted
+//
+// If `slice.PreferLinearOps() == false`, values are rearranged in place using an introselect built
+// on the same partitioning as `Sort()`, taking O(n) time on average and O(n log n) in the worst case.
+// If `slice.PreferLinearOps() == true`, the values are copied out in index order, selected on the
+// copy, and written back in index order, which requires memory for a copy of the values
+
+// Rearrange the slice so that the value `n` places after the first index is the value that would be
+// there if the slice were sorted, with no value before it greater than it and no value after it less than it
+//
+// Returns the index of that value. If `n` is not less than `Len()`, the slice is not changed
+// and the returned index is not valid
+func NthElement[T any, IDX Integer, S SliceLike[T, IDX]](slice S, n IDX, greaterThan func(a T, b T) (isGreaterThan bool)) (nthIdx IDX) {
+	first := slice.FirstIdx()
+	length := slice.Len()
+	if n >= length {
+		return slice.NthNextIdx(first, n)
+	}
+	if slice.PreferLinearOps() {
+		select_OnCopy(slice, func(vals *SliceAdapter[T]) {
+			NthElement(vals, int(n), greaterThan)
+		})
+		return slice.NthNextIdx(first, n)
+	}
+	sorter := pdqSorter[T, IDX, S]{
+		slice:       slice,
+		first:       first,
+		greaterThan: greaterThan,
+	}
+	sorter.pdqselect(0, int(length), int(n), bits.Len(uint(length)))
+	return slice.NthNextIdx(first, n)
+}
+func NthElementImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, n IDX) (nthIdx IDX) {
+	return NthElement(slice, n, GreaterThanImplicit)
+}
+
+// See `NthElement()`, using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+func NthElementFunc[T any, IDX Integer, S SliceLike[T, IDX]](slice S, n IDX, compare func(a T, b T) int) (nthIdx IDX) {
+	return NthElement(slice, n, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Rearrange the slice so that its `k` smallest values are sorted at its first `k` positions,
+// leaving the rest of the values in an unspecified order
+//
+// If `k >= Len()`, the whole slice is sorted. This sort is not stable
+func PartialSort[T any, IDX Integer, S SliceLike[T, IDX]](slice S, k IDX, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	length := slice.Len()
+	if k == 0 || length < 2 {
+		return
+	}
+	if k >= length {
+		Sort(slice, greaterThan)
+		return
+	}
+	if slice.PreferLinearOps() {
+		select_OnCopy(slice, func(vals *SliceAdapter[T]) {
+			PartialSort(vals, int(k), greaterThan)
+		})
+		return
+	}
+	sorter := pdqSorter[T, IDX, S]{
+		slice:       slice,
+		first:       slice.FirstIdx(),
+		greaterThan: greaterThan,
+	}
+	// The value at position `k - 1` is already in place after selecting it
+	sorter.pdqselect(0, int(length), int(k)-1, bits.Len(uint(length)))
+	sorter.pdqsort(0, int(k)-1, bits.Len(uint(k)-1))
+}
+func PartialSortImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S, k IDX) {
+	PartialSort(slice, k, GreaterThanImplicit)
+}
+
+// See `PartialSort()`, using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+func PartialSortFunc[T any, IDX Integer, S SliceLike[T, IDX]](slice S, k IDX, compare func(a T, b T) int) {
+	PartialSort(slice, k, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Append the `k` smallest values of the slice to `dest` in sorted order, without changing the slice
+//
+// The slice is walked once in index order while a bounded heap of the smallest values seen so far is
+// kept in memory, taking O(n log k) time for any implementation. Returns the number of values appended
+func PartialSortCopy[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, dest L, k IDX2, greaterThan func(a T, b T) (isGreaterThan bool)) (nAppended IDX2) {
+	if k == 0 {
+		return
+	}
+	lessThan := func(a T, b T) bool {
+		return greaterThan(b, a)
+	}
+	heap := EmptySliceAdapter[T](min(int(slice.Len()), int(k)))
+	DoActionOnAllItems(slice, func(slice S, idx IDX1, item T) {
+		select_PushBounded(&heap, item, int(k), lessThan)
+	})
+	Sort(&heap, greaterThan)
+	Append(dest, &heap)
+	return IDX2(heap.Len())
+}
+func PartialSortCopyImplicit[T Ordered, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, dest L, k IDX2) (nAppended IDX2) {
+	return PartialSortCopy(slice, dest, k, GreaterThanImplicit)
+}
+
+// See `PartialSortCopy()`, using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+func PartialSortCopyFunc[T any, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[T, IDX2]](slice S, dest L, k IDX2, compare func(a T, b T) int) (nAppended IDX2) {
+	return PartialSortCopy(slice, dest, k, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Consume every value in `queue`, keeping the `k` greatest values seen in `dest`
+//
+// `dest` is kept as a heap (see `HeapInit()`) whose first value is the least of the kept values,
+// so `TopK()` can be called again with the same `dest` as more values are queued. `dest` should
+// start empty and only be changed by `TopK()` until the stream ends, after which it can be sorted.
+// To keep the `k` smallest values instead, pass a function that reports whether `a` is less than `b`
+//
+// If `dest.PreferLinearOps() == true`, the heap is copied out and written back on each call
+// instead of being sifted in place. Returns the number of values consumed from `queue`
+func TopK[T any, IDX1 Integer, IDX2 Integer, Q QueueLike[T, IDX1], L ListLike[T, IDX2]](queue Q, dest L, k IDX2, greaterThan func(a T, b T) (isGreaterThan bool)) (nConsumed IDX1) {
+	nConsumed = queue.Len()
+	if k == 0 {
+		queue.IncrementStart(nConsumed)
+		return
+	}
+	if !dest.PreferLinearOps() {
+		DoActionOnAllItems(queue, func(queue Q, idx IDX1, item T) {
+			select_PushBounded(dest, item, k, greaterThan)
+		})
+		queue.IncrementStart(nConsumed)
+		return
+	}
+	heap := EmptySliceAdapter[T](int(dest.Len()))
+	Append(&heap, dest)
+	DoActionOnAllItems(queue, func(queue Q, idx IDX1, item T) {
+		select_PushBounded(&heap, item, int(k), greaterThan)
+	})
+	queue.IncrementStart(nConsumed)
+	dest.Clear()
+	Append(dest, &heap)
+	return
+}
+func TopKImplicit[T Ordered, IDX1 Integer, IDX2 Integer, Q QueueLike[T, IDX1], L ListLike[T, IDX2]](queue Q, dest L, k IDX2) (nConsumed IDX1) {
+	return TopK(queue, dest, k, GreaterThanImplicit)
+}
+
+// See `TopK()`, using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+func TopKFunc[T any, IDX1 Integer, IDX2 Integer, Q QueueLike[T, IDX1], L ListLike[T, IDX2]](queue Q, dest L, k IDX2, compare func(a T, b T) int) (nConsumed IDX1) {
+	return TopK(queue, dest, k, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Push `val` onto the heap in `list` while it holds fewer than `k` values,
+// otherwise replace the first value of the heap if `val` is greater than it
+func select_PushBounded[T any, IDX Integer, L ListLike[T, IDX]](list L, val T, k IDX, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	if list.Len() < k {
+		HeapPush(list, val, greaterThan)
+		return
+	}
+	first := list.FirstIdx()
+	if !greaterThan(val, list.Get(first)) {
+		return
+	}
+	list.Set(first, val)
+	HeapFix(list, first, greaterThan)
+}
+
+// Copy the values of the slice out in index order, pass the copy to `action()`,
+// then write the values back in index order
+func select_OnCopy[T any, IDX Integer, S SliceLike[T, IDX]](slice S, action func(vals *SliceAdapter[T])) {
+	vals := EmptySliceAdapter[T](int(slice.Len()))
+	DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+		AppendVar(&vals, item)
+	})
+	action(&vals)
+	pos := 0
+	DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+		slice.Set(idx, vals.Get(pos))
+		pos += 1
+	})
+}
+
+// Rearrange positions `a` to `b` (exclusive) so that position `nth` holds the value it would if
+// the range were sorted, falling back to heap sort after `limit` badly unbalanced partitions
+func (s *pdqSorter[T, IDX, S]) pdqselect(a int, b int, nth int, limit int) {
+	const maxInsertion = 12
+	wasBalanced := true
+	for {
+		length := b - a
+		if length <= maxInsertion {
+			s.insertionSort(a, b)
+			return
+		}
+		if limit == 0 {
+			s.heapSort(a, b)
+			return
+		}
+		if !wasBalanced {
+			s.breakPatterns(a, b)
+			limit -= 1
+		}
+		pivot, _ := s.choosePivot(a, b)
+		// Everything before `a` is known to be less than or equal to everything
+		// in range, so if the pivot equals it the range starts with duplicates
+		if a > 0 && !s.less(a-1, pivot) {
+			mid := s.partitionEqual(a, b, pivot)
+			if nth < mid {
+				return
+			}
+			a = mid
+			continue
+		}
+		mid, _ := s.partition(a, b, pivot)
+		if nth == mid {
+			return
+		}
+		wasBalanced = min(mid-a, b-mid-1) >= length/8
+		if nth < mid {
+			b = mid
+		} else {
+			a = mid + 1
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package go_list_like
+
+// The merging functions walk two slices sorted in ascending order by `compare` once from their first
+// index, writing the result to `dest` in sorted order, so they take O(n + m) time for any implementation
+//
+// If a value appears more than once in a slice, the result follows multiset rules: a value that
+// appears `x` times in `a` and `y` times in `b` appears `x + y` times in the merge, `max(x, y)` times
+// in the union, `min(x, y)` times in the intersection, `x - y` times in the difference, and `|x - y|`
+// times in the symmetric difference. Equal values from `a` are written before equal values from `b`
+//
+// The plain functions `Set()` values into `dest` from its first index and stop early if `dest` is
+// filled, while the `...AppendToList` functions append every value to the end of `dest`.
+// `nConsumedA` and `nConsumedB` report how many values from the start of each input were fully
+// accounted for in `dest`, so inputs that are `QueueLike` can be advanced with `IncrementStart()`
+// and merged again later. Once one input runs out, the rest of the other input is treated as if the
+// exhausted input holds nothing more, so when streaming, only merge once each input is either
+// non-empty or will not receive any more values
+
+// Write every value from `a` and `b` to `dest`
+func MergeSorted[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	return sorted_MergeToSlice(a, b, dest, compare, true, true, true, true)
+}
+func MergeSortedAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	return sorted_MergeAppendToList(a, b, dest, compare, true, true, true, true)
+}
+
+// Write every value that is in `a` or `b` to `dest`
+func UnionSorted[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	return sorted_MergeToSlice(a, b, dest, compare, false, true, true, true)
+}
+func UnionSortedAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	return sorted_MergeAppendToList(a, b, dest, compare, false, true, true, true)
+}
+
+// Write every value that is in both `a` and `b` to `dest`
+func IntersectSorted[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	return sorted_MergeToSlice(a, b, dest, compare, false, false, true, false)
+}
+func IntersectSortedAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	return sorted_MergeAppendToList(a, b, dest, compare, false, false, true, false)
+}
+
+// Write every value that is in `a` but not in `b` to `dest`
+func DifferenceSorted[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	return sorted_MergeToSlice(a, b, dest, compare, false, true, false, false)
+}
+func DifferenceSortedAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	return sorted_MergeAppendToList(a, b, dest, compare, false, true, false, false)
+}
+
+// Write every value that is in exactly one of `a` and `b` to `dest`
+func SymmetricDifferenceSorted[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	return sorted_MergeToSlice(a, b, dest, compare, false, true, false, true)
+}
+func SymmetricDifferenceSortedAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	return sorted_MergeAppendToList(a, b, dest, compare, false, true, false, true)
+}
+
+// Return whether every value in `b` is also in `a`, following the same multiset rules
+//
+// Stops at the first value of `b` that is not in `a`, which is not counted in `nConsumedB`
+func IncludesSorted[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](a S1, b S2, compare func(a T, b T) int) (nConsumedA IDX1, nConsumedB IDX2, includes bool) {
+	aIdx := a.FirstIdx()
+	bIdx := b.FirstIdx()
+	aOk := a.IdxValid(aIdx)
+	bOk := b.IdxValid(bIdx)
+	var order int
+	for aOk && bOk {
+		order = compare(a.Get(aIdx), b.Get(bIdx))
+		if order > 0 {
+			return
+		}
+		if order == 0 {
+			nConsumedB += 1
+			bIdx = b.NextIdx(bIdx)
+			bOk = b.IdxValid(bIdx)
+		}
+		nConsumedA += 1
+		aIdx = a.NextIdx(aIdx)
+		aOk = a.IdxValid(aIdx)
+	}
+	includes = !bOk
+	return
+}
+
+func sorted_MergeToSlice[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], S3 SliceLike[T, IDX3]](a S1, b S2, dest S3, compare func(a T, b T) int, keepAll bool, keepOnlyA bool, keepBoth bool, keepOnlyB bool) (nConsumedA IDX1, nConsumedB IDX2, nWritten IDX3, fullAConsumed bool, fullBConsumed bool, fullDestFilled bool) {
+	destIdx := dest.FirstIdx()
+	destOk := dest.IdxValid(destIdx)
+	nConsumedA, nConsumedB, fullAConsumed, fullBConsumed = sorted_Merge(a, b, compare, keepAll, keepOnlyA, keepBoth, keepOnlyB, func(val T) bool {
+		if !destOk {
+			return false
+		}
+		dest.Set(destIdx, val)
+		nWritten += 1
+		destIdx = dest.NextIdx(destIdx)
+		destOk = dest.IdxValid(destIdx)
+		return true
+	})
+	fullDestFilled = !destOk
+	return
+}
+
+func sorted_MergeAppendToList[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2], L ListLike[T, IDX3]](a S1, b S2, dest L, compare func(a T, b T) int, keepAll bool, keepOnlyA bool, keepBoth bool, keepOnlyB bool) (nConsumedA IDX1, nConsumedB IDX2, nAppended IDX3) {
+	nConsumedA, nConsumedB, _, _ = sorted_Merge(a, b, compare, keepAll, keepOnlyA, keepBoth, keepOnlyB, func(val T) bool {
+		AppendVar(dest, val)
+		nAppended += 1
+		return true
+	})
+	return
+}
+
+// Walk `a` and `b` together, passing each value that is kept to `write()` and stopping
+// before any value it refuses. With `keepAll`, equal values are taken one at a time
+// (from `a` first) instead of in pairs, and every value is kept
+func sorted_Merge[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](a S1, b S2, compare func(a T, b T) int, keepAll bool, keepOnlyA bool, keepBoth bool, keepOnlyB bool, write func(val T) bool) (nConsumedA IDX1, nConsumedB IDX2, fullAConsumed bool, fullBConsumed bool) {
+	aIdx := a.FirstIdx()
+	bIdx := b.FirstIdx()
+	aOk := a.IdxValid(aIdx)
+	bOk := b.IdxValid(bIdx)
+	var aVal, bVal T
+	var order int
+	for aOk && bOk {
+		aVal = a.Get(aIdx)
+		bVal = b.Get(bIdx)
+		order = compare(aVal, bVal)
+		switch {
+		case order < 0 || (order == 0 && keepAll):
+			if keepOnlyA && !write(aVal) {
+				return
+			}
+		case order == 0:
+			if keepBoth && !write(aVal) {
+				return
+			}
+		default:
+			if keepOnlyB && !write(bVal) {
+				return
+			}
+		}
+		if order <= 0 {
+			nConsumedA += 1
+			aIdx = a.NextIdx(aIdx)
+			aOk = a.IdxValid(aIdx)
+		}
+		if order > 0 || (order == 0 && !keepAll) {
+			nConsumedB += 1
+			bIdx = b.NextIdx(bIdx)
+			bOk = b.IdxValid(bIdx)
+		}
+	}
+	for aOk && keepOnlyA {
+		if !write(a.Get(aIdx)) {
+			break
+		}
+		nConsumedA += 1
+		aIdx = a.NextIdx(aIdx)
+		aOk = a.IdxValid(aIdx)
+	}
+	for bOk && keepOnlyB {
+		if !write(b.Get(bIdx)) {
+			break
+		}
+		nConsumedB += 1
+		bIdx = b.NextIdx(bIdx)
+		bOk = b.IdxValid(bIdx)
+	}
+	fullAConsumed = !aOk
+	fullBConsumed = !bOk
+	return
+}
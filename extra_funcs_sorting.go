@@ -49,15 +49,14 @@ func sorted_LinearInsert[T any, IDX Integer, L ListLike[T, IDX]](list L, val T,
 	return
 }
 
-// func Sorted_BinaryInsertWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, val T, equalOrder func(a, b T) bool, greaterThan func(a, b T) bool, indexMaps []IndexMap[T, IDX, L, LL]) (insertIdx IDX) {
-// 	insertIdx = sorted_BinaryInsert(list, val, equalOrder, greaterThan)
-// 	for _, m := range indexMaps {
-// 		m.MoveIndexesUp(list, insertIdx)
-// 		newMapIdx, _ := AppendVar(m.Indexes, insertIdx)
-// 		m.ResortAltered(list, newMapIdx)
-// 	}
-// 	return
-// }
+// Insert `val` into the sorted list, and add its index to every map in `maps`
+func SortedInsertWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, val T, equalOrder func(a, b T) bool, greaterThan func(a, b T) bool, maps []SortedIndexMap[T, IDX, L, LL]) (insertIdx IDX) {
+	insertIdx = SortedInsert(list, val, equalOrder, greaterThan)
+	for _, m := range maps {
+		m.afterInsert(list, insertIdx, insertIdx)
+	}
+	return
+}
 
 func sorted_BinaryInsertIndex[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, val TT, equalOrder func(a T, b TT) bool, greaterThan func(a T, b TT) bool) (insertIdx IDX, append bool) {
 	var lo IDX = slice.FirstIdx()
@@ -142,25 +141,20 @@ func sorted_BinaryLocate[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice
 	}
 }
 
+// Locates the first value that is equal to or greater than `locateVal` with the cursor
+// gallop of `sorted_LinearFirstIdxWhere()`, so it stops early when that value is near `lo`
 func sorted_LinearLocate[T any, TT any, IDX Integer, S SliceLike[T, IDX]](slice S, lo, hi IDX, locateVal TT, equalValue func(a T, b TT) bool, greaterThan func(a T, b TT) bool) (idx IDX, found bool, exitHi bool, exitLo bool) {
-	var val T
-	idx = lo
-	for {
-		val = slice.Get(idx)
-		if equalValue(val, locateVal) {
-			found = true
-			return
-		}
-		if greaterThan(val, locateVal) {
-			return
-		} else {
-			if idx == hi {
-				exitHi = true
-				return
-			}
-			idx = slice.NextIdx(idx)
-		}
+	idx, found = sorted_LinearFirstIdxWhere(slice, lo, hi, func(val T) bool {
+		return equalValue(val, locateVal) || greaterThan(val, locateVal)
+	})
+	if !found {
+		idx = hi
+		exitHi = true
+		return
 	}
+	found = equalValue(slice.Get(idx), locateVal)
+	exitLo = !found && idx == lo
+	return
 }
 
 // func sorted_BinaryFindMappedIdx[T any, IDX Integer, S SliceLike[T, IDX], L ListLike[IDX, IDX]](slice S, valIdx IDX, indexMap IndexMap[T, IDX, S, L]) (mapIdx IDX, found bool) {
@@ -0,0 +1,243 @@
+package go_list_like
+
+// Adapted from the insertion-sort-plus-SymMerge stable sort in the Go standard library `sort` package,
+// with SymMerge described in "Stable Minimum Storage Merging by Symmetric Comparisons"
+// by Pok-Son Kim and Arne Kutzner
+//
+// The algorithm works on positions `0 <= pos < n` and only touches the slice through `Get()` and `Set()`,
+// so the index holding each position never changes while sorting. If the slice's
+// indexes are consecutive and in order, positions map to indexes arithmetically, otherwise
+// the indexes are collected once up front so that linked implementations are not walked repeatedly.
+// That table takes O(n) memory, so only the consecutive case sorts without extra memory
+
+const stableSortBlockSize = 20
+
+type stableSorter[T any, IDX Integer, S SliceLike[T, IDX]] struct {
+	slice       S
+	first       IDX
+	idxs        []IDX
+	greaterThan func(a T, b T) bool
+	// If not nil, used to merge two adjacent sorted runs using scratch space,
+	// returning false if the scratch space could not be used
+	bufferedMerge func(a int, m int, b int) (merged bool)
+}
+
+func newStableSorter[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) bool) (sorter *stableSorter[T, IDX, S], n int) {
+	sorter = &stableSorter[T, IDX, S]{
+		slice:       slice,
+		first:       slice.FirstIdx(),
+		greaterThan: greaterThan,
+	}
+	if !slice.ConsecutiveIndexesInOrder() {
+		DoActionOnAllItems(slice, func(slice S, idx IDX, item T) {
+			sorter.idxs = append(sorter.idxs, idx)
+		})
+		return sorter, len(sorter.idxs)
+	}
+	if !slice.IdxValid(sorter.first) {
+		return sorter, 0
+	}
+	return sorter, int(slice.Len())
+}
+
+func (s *stableSorter[T, IDX, S]) idx(pos int) IDX {
+	if s.idxs != nil {
+		return s.idxs[pos]
+	}
+	return s.first + IDX(pos)
+}
+
+func (s *stableSorter[T, IDX, S]) get(pos int) T {
+	return s.slice.Get(s.idx(pos))
+}
+
+func (s *stableSorter[T, IDX, S]) set(pos int, val T) {
+	s.slice.Set(s.idx(pos), val)
+}
+
+// Return whether the value at position `i` sorts before the value at position `j`
+func (s *stableSorter[T, IDX, S]) less(i int, j int) bool {
+	return s.greaterThan(s.get(j), s.get(i))
+}
+
+func (s *stableSorter[T, IDX, S]) swap(i int, j int) {
+	valI := s.get(i)
+	s.set(i, s.get(j))
+	s.set(j, valI)
+}
+
+func (s *stableSorter[T, IDX, S]) sort(n int) {
+	a, b := 0, stableSortBlockSize
+	for b <= n {
+		s.insertionSort(a, b)
+		a = b
+		b += stableSortBlockSize
+	}
+	s.insertionSort(a, n)
+	for blockSize := stableSortBlockSize; blockSize < n; blockSize *= 2 {
+		a, b = 0, 2*blockSize
+		for b <= n {
+			s.merge(a, a+blockSize, b)
+			a = b
+			b += 2 * blockSize
+		}
+		if m := a + blockSize; m < n {
+			s.merge(a, m, n)
+		}
+	}
+}
+
+func (s *stableSorter[T, IDX, S]) insertionSort(a int, b int) {
+	for i := a + 1; i < b; i += 1 {
+		for j := i; j > a && s.less(j, j-1); j -= 1 {
+			s.swap(j, j-1)
+		}
+	}
+}
+
+// Merge the sorted runs at positions `a` to `m` and `m` to `b` (exclusive)
+func (s *stableSorter[T, IDX, S]) merge(a int, m int, b int) {
+	if !s.less(m, m-1) {
+		return
+	}
+	if s.bufferedMerge != nil && s.bufferedMerge(a, m, b) {
+		return
+	}
+	s.symMerge(a, m, b)
+}
+
+// Merge the sorted runs at positions `a` to `m` and `m` to `b` (exclusive) in place
+// by recursively rotating the out-of-order middle section
+func (s *stableSorter[T, IDX, S]) symMerge(a int, m int, b int) {
+	if m-a == 1 {
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if s.less(h, a) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k += 1 {
+			s.swap(k, k+1)
+		}
+		return
+	}
+	if b-m == 1 {
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if !s.less(m, h) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k -= 1 {
+			s.swap(k, k-1)
+		}
+		return
+	}
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if !s.less(p-c, c) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+	end := n - start
+	if start < m && m < end {
+		s.rotate(start, m, end)
+	}
+	if a < start && start < mid {
+		s.symMerge(a, start, mid)
+	}
+	if mid < end && end < b {
+		s.symMerge(mid, end, b)
+	}
+}
+
+// Exchange the adjacent position ranges `a` to `m` and `m` to `b` (exclusive)
+// using block swaps
+func (s *stableSorter[T, IDX, S]) rotate(a int, m int, b int) {
+	i := m - a
+	j := b - m
+	for i != j {
+		if i > j {
+			s.swapRange(m-i, m, j)
+			i -= j
+		} else {
+			s.swapRange(m-i, m+j-i, i)
+			j -= i
+		}
+	}
+	s.swapRange(m-i, m, i)
+}
+
+func (s *stableSorter[T, IDX, S]) swapRange(a int, b int, n int) {
+	for i := 0; i < n; i += 1 {
+		s.swap(a+i, b+i)
+	}
+}
+
+// Return a merge function that copies the left run into `scratch`,
+// then merges it with the right run back into the slice
+func stableBufferedMerge[T any, IDX Integer, IDX2 Integer, S SliceLike[T, IDX], L ListLike[T, IDX2]](s *stableSorter[T, IDX, S], scratch L) func(a int, m int, b int) bool {
+	return func(a int, m int, b int) bool {
+		scratch.Clear()
+		firstSlot, _, ok := TryAppendSlots(scratch, IDX2(m-a))
+		if !ok {
+			return false
+		}
+		scratchIdx := firstSlot
+		for pos := a; pos < m; pos += 1 {
+			scratch.Set(scratchIdx, s.get(pos))
+			scratchIdx = scratch.NextIdx(scratchIdx)
+		}
+		scratchIdx = firstSlot
+		leftRemaining := m - a
+		leftVal := scratch.Get(scratchIdx)
+		right, dest := m, a
+		rightVal := s.get(right)
+		for leftRemaining > 0 && right < b {
+			if s.greaterThan(leftVal, rightVal) {
+				s.set(dest, rightVal)
+				right += 1
+				if right < b {
+					rightVal = s.get(right)
+				}
+			} else {
+				s.set(dest, leftVal)
+				leftRemaining -= 1
+				if leftRemaining > 0 {
+					scratchIdx = scratch.NextIdx(scratchIdx)
+					leftVal = scratch.Get(scratchIdx)
+				}
+			}
+			dest += 1
+		}
+		for leftRemaining > 0 {
+			s.set(dest, leftVal)
+			dest += 1
+			leftRemaining -= 1
+			if leftRemaining > 0 {
+				scratchIdx = scratch.NextIdx(scratchIdx)
+				leftVal = scratch.Get(scratchIdx)
+			}
+		}
+		return true
+	}
+}
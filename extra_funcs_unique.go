@@ -0,0 +1,77 @@
+package go_list_like
+
+// The unique functions remove repeated values from a list in a single pass, keeping the first
+// of each group of equal values and the order of the values that remain. See `RemoveIf()`
+
+// Remove every value that is equal to the value before it, which removes all duplicates
+// when the list is sorted (like `slices.Compact()`)
+//
+// Returns the number of values removed
+func UniqueSorted[T any, IDX Integer, L ListLike[T, IDX]](list L, equal func(a T, b T) (isEqual bool)) (nRemoved IDX) {
+	var prev T
+	hasPrev := false
+	return RemoveIf(list, func(item T) bool {
+		if hasPrev && equal(prev, item) {
+			return true
+		}
+		prev = item
+		hasPrev = true
+		return false
+	})
+}
+func UniqueSortedImplicit[T Equatable, IDX Integer, L ListLike[T, IDX]](list L) (nRemoved IDX) {
+	return UniqueSorted(list, EqualImplicit)
+}
+
+// Remove every value that is equal to any value before it, using a hash set of the values seen
+//
+// Returns the number of values removed
+func UniqueStable[T comparable, IDX Integer, L ListLike[T, IDX]](list L) (nRemoved IDX) {
+	return UniqueStableByKey(list, func(item T) T {
+		return item
+	})
+}
+
+// Remove every value whose `key()` is equal to the key of any value before it,
+// using a hash set of the keys seen
+//
+// Returns the number of values removed
+func UniqueStableByKey[T any, K comparable, IDX Integer, L ListLike[T, IDX]](list L, key func(item T) K) (nRemoved IDX) {
+	seen := make(map[K]struct{})
+	return RemoveIf(list, func(item T) bool {
+		k := key(item)
+		if _, isSeen := seen[k]; isSeen {
+			return true
+		}
+		seen[k] = struct{}{}
+		return false
+	})
+}
+
+// Append the index of every value that is equal to any value before it to `dest`, without
+// changing the slice. These are the values `UniqueStable()` would remove
+//
+// Returns the number of indexes appended
+func DuplicatesInto[T comparable, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[IDX1, IDX2]](slice S, dest L) (nDuplicates IDX2) {
+	return DuplicatesIntoByKey(slice, dest, func(item T) T {
+		return item
+	})
+}
+
+// Append the index of every value whose `key()` is equal to the key of any value before it
+// to `dest`, without changing the slice. These are the values `UniqueStableByKey()` would remove
+//
+// Returns the number of indexes appended
+func DuplicatesIntoByKey[T any, K comparable, IDX1 Integer, IDX2 Integer, S SliceLike[T, IDX1], L ListLike[IDX1, IDX2]](slice S, dest L, key func(item T) K) (nDuplicates IDX2) {
+	seen := make(map[K]struct{})
+	DoActionOnAllItems(slice, func(slice S, idx IDX1, item T) {
+		k := key(item)
+		if _, isSeen := seen[k]; isSeen {
+			AppendVar(dest, idx)
+			nDuplicates += 1
+			return
+		}
+		seen[k] = struct{}{}
+	})
+	return
+}
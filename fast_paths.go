@@ -0,0 +1,221 @@
+package go_list_like
+
+import (
+	"slices"
+	"unsafe"
+)
+
+// Fast paths let the generic helpers skip per-item `Get()`/`Set()` calls when an implementation
+// exposes its memory. A range of a `SliceLike` can be handled as a golang slice when its indexes are
+// consecutive and in order, and either:
+//   - it implements `GoSliceLike`, where index `i` refers to `GoSlice()[i]`, or
+//   - it implements `ContiguousMemSliceLike`, and `ContiguousSpan()` reports the range is contiguous
+//
+// A plain `MemSliceLike` is never handled as a golang slice, because pointers alone cannot show that
+// the memory between two values holds only the values in between
+//
+// Otherwise, implementations of `BulkSliceLike` (such as the file adapters) transfer whole ranges
+// with `GetRange()`/`SetRange()`. When only one side of a copy is a `BulkSliceLike` without memory,
+// the values pass through a temporary buffer, but only when both sides report their storage and it
+// differs (for example a file adapter and a ring buffer, but not two adapters over the same file).
+// Copies between sides that may share storage fall back to `Get()`/`Set()`
+
+// The size in bytes of the temporary buffer used to move values to or from a `BulkSliceLike`
+const fastPathChunkBytes = 64 * 1024
+
+// Return the number of values of type `T` that fit in the temporary buffer, but no more than `n`
+func fastPathChunkLen[T any](n int) int {
+	var zero T
+	size := max(1, int(unsafe.Sizeof(zero)))
+	return min(n, max(1, fastPathChunkBytes/size))
+}
+
+// Return the number of items from `firstIdx` to `lastIdx` (inclusive) if `lastIdx` is reachable
+// from `firstIdx`, otherwise the number of items from `firstIdx` to the end of the slice
+//
+// `ok == false` if the slice's indexes are not consecutive or `firstIdx` is invalid
+func consecutiveRangeLen[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX) (n int, reachesLast bool, ok bool) {
+	if !slice.ConsecutiveIndexesInOrder() || !slice.IdxValid(firstIdx) {
+		return
+	}
+	sliceLast := slice.LastIdx()
+	if lastIdx >= firstIdx && lastIdx <= sliceLast {
+		return int(lastIdx-firstIdx) + 1, true, true
+	}
+	return int(sliceLast-firstIdx) + 1, false, true
+}
+
+// Implemented by slices that can report the storage they read and write, so that a copy only passes
+// values through a temporary buffer when the two sides cannot share storage
+type storageReporter interface {
+	// Return a value identifying the storage the slice reads and writes (such as its `*os.File`),
+	// or `known == false` if it cannot be identified
+	storage() (token any, known bool)
+}
+
+// Return the storage of `slice` if it implements `storageReporter`
+func storageOf(slice any) (token any, known bool) {
+	if reporter, isReporter := slice.(storageReporter); isReporter {
+		return reporter.storage()
+	}
+	return nil, false
+}
+
+// Whether `a` and `b` are both known to use storage that the other does not
+func storageDistinct(a any, b any) bool {
+	tokenA, knownA := storageOf(a)
+	tokenB, knownB := storageOf(b)
+	return knownA && knownB && tokenA != tokenB
+}
+
+// Return the `n` values starting at `firstIdx` as a golang slice that shares memory with `slice`
+//
+// Assumes `n` consecutive indexes starting at `firstIdx` are valid
+func memSpan[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, n int) (span []T, ok bool) {
+	if n <= 0 {
+		return
+	}
+	if goSlice, isGoSlice := any(slice).(GoSliceLike[T]); isGoSlice {
+		data := goSlice.GoSlice()
+		first := int(firstIdx)
+		if first < 0 || first+n > len(data) {
+			return
+		}
+		return data[first : first+n], true
+	}
+	if contiguous, isContiguous := any(slice).(ContiguousMemSliceLike[T, IDX]); isContiguous {
+		return contiguous.ContiguousSpan(firstIdx, IDX(n))
+	}
+	return
+}
+
+// Whether copying `src` into `dest` one item at a time from the front would give a
+// different result than the built-in `copy()`
+func spansOverlapForward[T any](src []T, dest []T) bool {
+	size := unsafe.Sizeof(src[0])
+	srcStart := uintptr(unsafe.Pointer(&src[0]))
+	destStart := uintptr(unsafe.Pointer(&dest[0]))
+	return destStart > srcStart && destStart < srcStart+uintptr(len(src))*size
+}
+
+// Attempt to perform `copyCountFromRangeToRange_internal()` on whole ranges at once,
+// returning `ok == false` if neither side can be handled as a golang slice
+func fastCopy[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](source S1, firstSourceIdx IDX1, lastSourceIdx IDX1, dest S2, firstDestIdx IDX2, lastDestIdx IDX2, count IDX1, forceCount bool) (nCopied IDX1, fullSourceCopied bool, fullDestCopied bool, nextSourceIdx IDX1, nextDestIdx IDX2, ok bool) {
+	srcLen, srcReachesLast, ok := consecutiveRangeLen(source, firstSourceIdx, lastSourceIdx)
+	if !ok {
+		return
+	}
+	destLen, destReachesLast, ok := consecutiveRangeLen(dest, firstDestIdx, lastDestIdx)
+	if !ok {
+		return
+	}
+	n := min(srcLen, destLen)
+	if forceCount {
+		n = min(n, int(count))
+	}
+	if n <= 0 {
+		return 0, false, false, 0, 0, false
+	}
+	srcSpan, srcIsMem := memSpan(source, firstSourceIdx, n)
+	destSpan, destIsMem := memSpan(dest, firstDestIdx, n)
+	srcBulk, srcIsBulk := any(source).(BulkSliceLike[T, IDX1])
+	destBulk, destIsBulk := any(dest).(BulkSliceLike[T, IDX2])
+	switch {
+	case srcIsMem && destIsMem:
+		if spansOverlapForward(srcSpan, destSpan) {
+			return 0, false, false, 0, 0, false
+		}
+		copy(destSpan, srcSpan)
+	case srcIsMem && destIsBulk:
+		destBulk.SetRange(firstDestIdx, srcSpan)
+	case destIsMem && srcIsBulk:
+		srcBulk.GetRange(firstSourceIdx, firstSourceIdx+IDX1(n-1), destSpan)
+	case srcIsBulk && !srcIsMem && !destIsBulk && !destIsMem && storageDistinct(source, dest):
+		chunk := make([]T, fastPathChunkLen[T](n))
+		for done := 0; done < n; done += len(chunk) {
+			part := chunk[:min(len(chunk), n-done)]
+			srcIdx := firstSourceIdx + IDX1(done)
+			srcBulk.GetRange(srcIdx, srcIdx+IDX1(len(part)-1), part)
+			destIdx := firstDestIdx + IDX2(done)
+			for i, val := range part {
+				dest.Set(destIdx+IDX2(i), val)
+			}
+		}
+	case destIsBulk && !destIsMem && !srcIsBulk && !srcIsMem && storageDistinct(source, dest):
+		chunk := make([]T, fastPathChunkLen[T](n))
+		for done := 0; done < n; done += len(chunk) {
+			part := chunk[:min(len(chunk), n-done)]
+			srcIdx := firstSourceIdx + IDX1(done)
+			for i := range part {
+				part[i] = source.Get(srcIdx + IDX1(i))
+			}
+			destBulk.SetRange(firstDestIdx+IDX2(done), part)
+		}
+	default:
+		return 0, false, false, 0, 0, false
+	}
+	nCopied = IDX1(n)
+	fullSourceCopied = srcReachesLast && n == srcLen
+	fullDestCopied = destReachesLast && n == destLen
+	nextSourceIdx = source.NextIdx(firstSourceIdx + IDX1(n-1))
+	nextDestIdx = dest.NextIdx(firstDestIdx + IDX2(n-1))
+	return
+}
+
+// Attempt to perform `fillCountFromRange_internal()` on the whole range at once,
+// returning `ok == false` if the slice cannot be handled as a golang slice
+func fastFill[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX, count IDX, forceCount bool, val T) (nFilled IDX, fullRangeFilled bool, nextIdx IDX, ok bool) {
+	rangeLen, reachesLast, ok := consecutiveRangeLen(slice, firstIdx, lastIdx)
+	if !ok {
+		return
+	}
+	n := rangeLen
+	if forceCount {
+		n = min(n, int(count))
+	}
+	if n <= 0 {
+		return 0, false, 0, false
+	}
+	if span, isMem := memSpan(slice, firstIdx, n); isMem {
+		fillSpan(span, val)
+	} else if bulk, isBulk := any(slice).(BulkSliceLike[T, IDX]); isBulk {
+		chunk := make([]T, fastPathChunkLen[T](n))
+		fillSpan(chunk, val)
+		for done := 0; done < n; done += len(chunk) {
+			bulk.SetRange(firstIdx+IDX(done), chunk[:min(len(chunk), n-done)])
+		}
+	} else {
+		return 0, false, 0, false
+	}
+	nFilled = IDX(n)
+	fullRangeFilled = reachesLast && n == rangeLen
+	nextIdx = slice.NextIdx(firstIdx + IDX(n-1))
+	return
+}
+
+// Set every value in `span` to `val`, doubling the filled portion with each `copy()`
+func fillSpan[T any](span []T, val T) {
+	if len(span) == 0 {
+		return
+	}
+	span[0] = val
+	for filled := 1; filled < len(span); filled *= 2 {
+		copy(span[filled:], span[:filled])
+	}
+}
+
+// Attempt to reverse the whole slice at once, returning `ok == false`
+// if the slice cannot be handled as a golang slice
+func fastReverse[T any, IDX Integer, S SliceLike[T, IDX]](slice S) (ok bool) {
+	firstIdx := slice.FirstIdx()
+	n, _, ok := consecutiveRangeLen(slice, firstIdx, slice.LastIdx())
+	if !ok {
+		return
+	}
+	span, ok := memSpan(slice, firstIdx, n)
+	if !ok {
+		return
+	}
+	slices.Reverse(span)
+	return
+}
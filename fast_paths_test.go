@@ -0,0 +1,351 @@
+package go_list_like
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+// Hides `GoSlice()`, `GetPtr()` and file access so that helpers fall back to `Get()`/`Set()`
+type itemwiseSlice struct {
+	SliceLike[byte, int]
+}
+
+// Hides `GoSlice()`, `GetPtr()` and file access so that helpers fall back to `Get()`/`Set()`
+type itemwiseList struct {
+	ListLike[byte, int]
+}
+
+func collectBytes(slice SliceLike[byte, int]) []byte {
+	vals := make([]byte, 0, slice.Len())
+	DoActionOnAllItems(slice, func(slice SliceLike[byte, int], idx int, item byte) {
+		vals = append(vals, item)
+	})
+	return vals
+}
+
+// Builds a ring buffer whose data starts `rotation` places into its backing memory,
+// so that some ranges are contiguous and others wrap around the end
+func newRotatedRingBuffer(data []byte, rotation byte) *RingBuffer[byte] {
+	ring := EmptyRingBuffer[byte](len(data) + 4)
+	for range rotation % 4 {
+		ring.PushBack(0)
+	}
+	ring.IncrementStart(int(rotation % 4))
+	for _, b := range data {
+		ring.PushBack(b)
+	}
+	return &ring
+}
+
+// Returns a constructor, keyed by name, for each `ListLike` that the list algorithms are
+// checked against. The ring buffer's data starts `rotation` places into its backing memory
+func newByteLists(rotation byte) map[string]func(vals []byte) ListLike[byte, int] {
+	return map[string]func(vals []byte) ListLike[byte, int]{
+		"SliceAdapter": func(vals []byte) ListLike[byte, int] {
+			slice := NewSliceAdapter(vals)
+			return &slice
+		},
+		"LinkedList": func(vals []byte) ListLike[byte, int] {
+			list := NewLinkedList(vals)
+			return &list
+		},
+		"RingBuffer": func(vals []byte) ListLike[byte, int] {
+			return newRotatedRingBuffer(vals, rotation)
+		},
+	}
+}
+
+func Fuzz_FastPaths_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4}, []byte{0, 4, 1, 3, 0, 1, 7})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109}, []byte{3, 2, 5, 0, 1, 9, 1})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, []byte{1, 0, 9, 2, 8, 6, 0})
+	f.Fuzz(func(t *testing.T, a []byte, params []byte) {
+		if len(params) < 7 {
+			return
+		}
+		firstSrc, lastSrc := int(params[0])-1, int(params[1])-1
+		firstDest, lastDest := int(params[2])-1, int(params[3])-1
+		count, forceCount := int(params[4]), params[5]%2 == 0
+		rotation := params[6]
+		// Copy within the same slice, which may overlap
+		sa := NewSliceAdapter(slices.Clone(a))
+		ring := newRotatedRingBuffer(a, rotation)
+		expSlice := NewSliceAdapter(slices.Clone(a))
+		exp := itemwiseSlice{&expSlice}
+		if !exp.IdxValid(firstSrc) {
+			return
+		}
+		expN, expFullSrc, expFullDest, expNextSrc, expNextDest := copyCountFromRangeToRange_internal(exp, firstSrc, lastSrc, exp, firstDest, lastDest, count, forceCount)
+		for _, got := range []SliceLike[byte, int]{&sa, ring} {
+			n, fullSrc, fullDest, nextSrc, nextDest := copyCountFromRangeToRange_internal(got, firstSrc, lastSrc, got, firstDest, lastDest, count, forceCount)
+			if n != expN || fullSrc != expFullSrc || fullDest != expFullDest || nextSrc != expNextSrc || nextDest != expNextDest {
+				t.Errorf("\ntest case failed: copy results differ from item-by-item copy\nEXP: %d %t %t %d %d\nGOT: %d %t %t %d %d\n", expN, expFullSrc, expFullDest, expNextSrc, expNextDest, n, fullSrc, fullDest, nextSrc, nextDest)
+			}
+			if !slices.Equal(collectBytes(exp), collectBytes(got)) {
+				t.Errorf("\ntest case failed: copied values differ from item-by-item copy\nEXP: %v\nGOT: %v\n", collectBytes(exp), collectBytes(got))
+			}
+		}
+		// Fill
+		val := params[6]
+		expFillN, expFullRange, expNextIdx := fillCountFromRange_internal(exp, firstSrc, lastSrc, count, forceCount, val)
+		for _, got := range []SliceLike[byte, int]{&sa, ring} {
+			n, fullRange, nextIdx := fillCountFromRange_internal(got, firstSrc, lastSrc, count, forceCount, val)
+			if n != expFillN || fullRange != expFullRange || nextIdx != expNextIdx {
+				t.Errorf("\ntest case failed: fill results differ from item-by-item fill\nEXP: %d %t %d\nGOT: %d %t %d\n", expFillN, expFullRange, expNextIdx, n, fullRange, nextIdx)
+			}
+			if !slices.Equal(collectBytes(exp), collectBytes(got)) {
+				t.Errorf("\ntest case failed: filled values differ from item-by-item fill\nEXP: %v\nGOT: %v\n", collectBytes(exp), collectBytes(got))
+			}
+		}
+		// Reverse
+		Reverse(exp)
+		for _, got := range []SliceLike[byte, int]{&sa, ring} {
+			Reverse(got)
+			if !slices.Equal(collectBytes(exp), collectBytes(got)) {
+				t.Errorf("\ntest case failed: reversed values differ from item-by-item reverse\nEXP: %v\nGOT: %v\n", collectBytes(exp), collectBytes(got))
+			}
+		}
+	})
+}
+
+// A `MemSliceLike` that stores its first and last values at the ends of its memory but the values
+// in between in reverse, so the pointers to the ends are as far apart as in a golang slice
+type scrambledMemSlice struct {
+	SliceLike[byte, int]
+	data []byte
+}
+
+func (slice scrambledMemSlice) pos(idx int) int {
+	if idx == 0 || idx == len(slice.data)-1 {
+		return idx
+	}
+	return len(slice.data) - 1 - idx
+}
+func (slice scrambledMemSlice) Get(idx int) byte {
+	return slice.data[slice.pos(idx)]
+}
+func (slice scrambledMemSlice) Set(idx int, val byte) {
+	slice.data[slice.pos(idx)] = val
+}
+func (slice scrambledMemSlice) GetPtr(idx int) *byte {
+	return &slice.data[slice.pos(idx)]
+}
+
+var _ MemSliceLike[byte, int] = scrambledMemSlice{}
+
+func Test_FastPathsNonContiguousMem_(t *testing.T) {
+	src := NewSliceAdapter([]byte{0, 1, 2, 3, 4, 5, 6, 7})
+	backing := NewSliceAdapter(make([]byte, src.Len()))
+	dest := scrambledMemSlice{&backing, backing.GoSlice()}
+	Copy(&src, dest)
+	if got := collectBytes(dest); !slices.Equal(got, src.GoSlice()) {
+		t.Errorf("\ntest case failed: copy into non-contiguous MemSliceLike\nEXP: %v\nGOT: %v\n", src.GoSlice(), got)
+	}
+	Reverse(dest)
+	exp := slices.Clone(src.GoSlice())
+	slices.Reverse(exp)
+	if got := collectBytes(dest); !slices.Equal(got, exp) {
+		t.Errorf("\ntest case failed: reverse of non-contiguous MemSliceLike\nEXP: %v\nGOT: %v\n", exp, got)
+	}
+}
+
+func newTempFileAdapter(t *testing.T, data []byte) FileAdapter {
+	file, err := os.CreateTemp(t.TempDir(), "go_list_like_fuzz_*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+	if _, err = file.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	return NewFileAdapter(file)
+}
+
+// Copies between a `FileAdapter` and a view over a `FileSliceAdapter` of the same file, with the
+// ranges overlapping, must give the same result as copying item by item
+func Test_FastPathsSameFile_(t *testing.T) {
+	a := make([]byte, 64)
+	for i := range a {
+		a[i] = byte(i)
+	}
+	for _, shift := range []int{1, -1} {
+		file := newTempFileAdapter(t, a)
+		view := NewWindowView(file.Slice(0, len(a)-1), 1, len(a)-1)
+		expSlice := NewSliceAdapter(slices.Clone(a))
+		exp := itemwiseSlice{&expSlice}
+		expView := NewWindowView[byte, int](exp, 1, len(a)-1)
+		var expResults, gotResults []any
+		if shift > 0 {
+			n, fullSrc, fullDest, nextSrc, nextDest := copyCountFromRangeToRange_internal[byte](exp, 0, len(a)-2, expView, 0, len(a)-2, 0, false)
+			expResults = []any{n, fullSrc, fullDest, nextSrc, nextDest}
+			n, fullSrc, fullDest, nextSrc, nextDest = copyCountFromRangeToRange_internal[byte](file, 0, len(a)-2, view, 0, len(a)-2, 0, false)
+			gotResults = []any{n, fullSrc, fullDest, nextSrc, nextDest}
+		} else {
+			n, fullSrc, fullDest, nextSrc, nextDest := copyCountFromRangeToRange_internal[byte](expView, 0, len(a)-2, exp, 0, len(a)-2, 0, false)
+			expResults = []any{n, fullSrc, fullDest, nextSrc, nextDest}
+			n, fullSrc, fullDest, nextSrc, nextDest = copyCountFromRangeToRange_internal[byte](view, 0, len(a)-2, file, 0, len(a)-2, 0, false)
+			gotResults = []any{n, fullSrc, fullDest, nextSrc, nextDest}
+		}
+		if !slices.Equal(expResults, gotResults) || !slices.Equal(collectBytes(exp), collectBytes(file)) {
+			t.Errorf("\ntest case failed: same-file copy (shift %d) differs from item-by-item copy\nEXP: %v %v\nGOT: %v %v\n", shift, expResults, collectBytes(exp), gotResults, collectBytes(file))
+		}
+	}
+}
+
+func Fuzz_BulkSlice_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4}, []byte{0, 4, 1, 3, 0, 1, 7})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109}, []byte{3, 2, 5, 0, 1, 9, 1})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, []byte{1, 0, 9, 2, 8, 6, 3})
+	f.Fuzz(func(t *testing.T, a []byte, params []byte) {
+		if len(params) < 7 {
+			return
+		}
+		firstSrc, lastSrc := int(params[0])-1, int(params[1])-1
+		firstDest, lastDest := int(params[2])-1, int(params[3])-1
+		count, forceCount := int(params[4]), params[5]%2 == 0
+		rotation := params[6]
+		ringData := slices.Clone(a)
+		slices.Reverse(ringData)
+		file := newTempFileAdapter(t, a)
+		ring := newRotatedRingBuffer(ringData, rotation)
+		expFileSlice := NewSliceAdapter(slices.Clone(a))
+		expRingSlice := NewSliceAdapter(slices.Clone(ringData))
+		expFile := itemwiseSlice{&expFileSlice}
+		expRing := itemwiseSlice{&expRingSlice}
+		check := func(op string, expResults []any, gotResults []any) {
+			if !slices.Equal(expResults, gotResults) {
+				t.Errorf("\ntest case failed: %s results differ from item-by-item %s\nEXP: %v\nGOT: %v\n", op, op, expResults, gotResults)
+			}
+			if !slices.Equal(collectBytes(expFile), collectBytes(file)) || !slices.Equal(collectBytes(expRing), collectBytes(ring)) {
+				t.Errorf("\ntest case failed: values after %s differ from item-by-item %s\nEXP: %v %v\nGOT: %v %v\n", op, op, collectBytes(expFile), collectBytes(expRing), collectBytes(file), collectBytes(ring))
+			}
+			if err := file.Err(); err != nil {
+				t.Errorf("\ntest case failed: FileAdapter recorded an error during %s: %s\n", op, err)
+			}
+		}
+		// File to ring buffer
+		n, fullSrc, fullDest, nextSrc, nextDest := copyCountFromRangeToRange_internal(expFile, firstSrc, lastSrc, expRing, firstDest, lastDest, count, forceCount)
+		expResults := []any{n, fullSrc, fullDest, nextSrc, nextDest}
+		n, fullSrc, fullDest, nextSrc, nextDest = copyCountFromRangeToRange_internal[byte](file, firstSrc, lastSrc, ring, firstDest, lastDest, count, forceCount)
+		check("copy from file", expResults, []any{n, fullSrc, fullDest, nextSrc, nextDest})
+		// Ring buffer to file
+		n, fullSrc, fullDest, nextSrc, nextDest = copyCountFromRangeToRange_internal(expRing, firstDest, lastDest, expFile, firstSrc, lastSrc, count, forceCount)
+		expResults = []any{n, fullSrc, fullDest, nextSrc, nextDest}
+		n, fullSrc, fullDest, nextSrc, nextDest = copyCountFromRangeToRange_internal[byte](ring, firstDest, lastDest, file, firstSrc, lastSrc, count, forceCount)
+		check("copy to file", expResults, []any{n, fullSrc, fullDest, nextSrc, nextDest})
+		// Fill file
+		nFilled, fullRange, nextIdx := fillCountFromRange_internal(expFile, firstSrc, lastSrc, count, forceCount, rotation)
+		expResults = []any{nFilled, fullRange, nextIdx}
+		nFilled, fullRange, nextIdx = fillCountFromRange_internal(file, firstSrc, lastSrc, count, forceCount, rotation)
+		check("fill", expResults, []any{nFilled, fullRange, nextIdx})
+	})
+}
+
+const benchmarkFastPathLen = 64 * 1024
+
+func benchmarkBytes() []byte {
+	data := make([]byte, benchmarkFastPathLen)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	return data
+}
+
+func Benchmark_Copy_(b *testing.B) {
+	src := NewSliceAdapter(benchmarkBytes())
+	dest := NewSliceAdapter(make([]byte, benchmarkFastPathLen))
+	b.Run("fast", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Copy(&src, &dest)
+		}
+	})
+	b.Run("itemwise", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Copy(itemwiseSlice{&src}, itemwiseSlice{&dest})
+		}
+	})
+}
+
+func Benchmark_Fill_(b *testing.B) {
+	slice := NewSliceAdapter(make([]byte, benchmarkFastPathLen))
+	b.Run("fast", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Fill(&slice, byte(i))
+		}
+	})
+	b.Run("itemwise", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Fill(itemwiseSlice{&slice}, byte(i))
+		}
+	})
+}
+
+func Benchmark_Reverse_(b *testing.B) {
+	slice := NewSliceAdapter(benchmarkBytes())
+	b.Run("fast", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Reverse(&slice)
+		}
+	})
+	b.Run("itemwise", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Reverse(itemwiseSlice{&slice})
+		}
+	})
+}
+
+func Benchmark_Append_(b *testing.B) {
+	vals := NewSliceAdapter(benchmarkBytes())
+	list := EmptySliceAdapter[byte](benchmarkFastPathLen)
+	b.Run("fast", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			list.Clear()
+			Append(&list, &vals)
+		}
+	})
+	b.Run("itemwise", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			list.Clear()
+			Append(itemwiseList{&list}, itemwiseSlice{&vals})
+		}
+	})
+}
+
+func Benchmark_CopyToFile_(b *testing.B) {
+	file, err := os.CreateTemp(b.TempDir(), "go_list_like_bench_*")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer file.Close()
+	src := NewSliceAdapter(benchmarkBytes())
+	dest := NewFileAdapter(file)
+	AppendSlots(dest, benchmarkFastPathLen)
+	b.Run("fast", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Copy(&src, dest)
+		}
+	})
+	b.Run("itemwise", func(b *testing.B) {
+		b.SetBytes(benchmarkFastPathLen)
+		for i := 0; i < b.N; i += 1 {
+			Copy(itemwiseSlice{&src}, itemwiseList{dest})
+		}
+	})
+	if err = dest.Err(); err != nil {
+		b.Fatal(err)
+	}
+}
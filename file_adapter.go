@@ -7,13 +7,42 @@ import (
 	"time"
 )
 
+// The default number of bytes a `FileAdapter` moves per read/write
+// when shifting data within the file
+const DefaultFileChunkSize = 64 * 1024
+
+// Adapts an `*os.File` into a `ListLike[byte, int]`
+//
+// I/O errors encountered by the `SliceLike` and `ListLike` methods are recorded
+// and reported by `Err()` (see `ErrorReporter`). Each adapter created with `NewFileAdapter()`
+// or `NewFileAdapterWithChunkSize()` records its own error, which is shared by copies of that
+// adapter but not by other adapters over the same `*os.File`. An adapter created with a literal
+// such as `FileAdapter{File: file}` has nowhere to record an error, so it panics with it instead
 type FileAdapter struct {
 	File *os.File
+	// The number of bytes moved per read/write when shifting data within the file,
+	// for example by `Move()`, `MoveRange()`, `InsertSlotsAssumeCapacity()`, and `DeleteRange()`
+	//
+	// If `<= 0`, `DefaultFileChunkSize` is used
+	ChunkSize int
+	errState  *fileAdapterErr
+}
+
+type fileAdapterErr struct {
+	err error
 }
 
 func NewFileAdapter(file *os.File) FileAdapter {
 	return FileAdapter{
-		File: file,
+		File:     file,
+		errState: &fileAdapterErr{},
+	}
+}
+func NewFileAdapterWithChunkSize(file *os.File, chunkSize int) FileAdapter {
+	return FileAdapter{
+		File:      file,
+		ChunkSize: chunkSize,
+		errState:  &fileAdapterErr{},
 	}
 }
 
@@ -62,60 +91,46 @@ func (f FileAdapter) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
 // Get the value at the provided index
 func (f FileAdapter) Get(idx int) (val byte) {
 	var b [1]byte
-	f.ReadAt(b[:], int64(idx))
+	_, err := f.ReadAt(b[:], int64(idx))
+	f.recordErr(err)
 	return b[0]
 }
 
 // Set the value at the provided index to the given value
 func (f FileAdapter) Set(idx int, val byte) {
 	var b [1]byte = [1]byte{val}
-	f.WriteAt(b[:], int64(idx))
+	_, err := f.WriteAt(b[:], int64(idx))
+	f.recordErr(err)
 }
 
-// Move only the data contained at `oldIdx` to `newIdx`,
-// overwriting the existing data at `newIdx`
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
 func (f FileAdapter) Move(oldIdx int, newIdx int) {
-	val := f.Get(oldIdx)
 	if newIdx < oldIdx {
-		prevIdx := oldIdx - 1
-		for oldIdx > newIdx {
-			Overwrite(f, prevIdx, oldIdx)
-			oldIdx = prevIdx
-			prevIdx -= 1
-		}
+		f.recordErr(rotate(f.File, f.chunkSize(), int64(newIdx), int64(oldIdx), int64(oldIdx)+1))
 	} else {
-		nextIdx := oldIdx + 1
-		for oldIdx < newIdx {
-			Overwrite(f, nextIdx, oldIdx)
-			oldIdx = nextIdx
-			nextIdx += 1
-		}
+		f.recordErr(rotate(f.File, f.chunkSize(), int64(oldIdx), int64(oldIdx)+1, int64(newIdx)+1))
 	}
-	f.Set(newIdx, val)
 }
 
 // Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
 // and re-insert it at the `newFirstIdx` position
 func (f FileAdapter) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
 	lenA := (lastIdx - firstIdx) + 1
-	sliceA := f.Slice(firstIdx, (firstIdx+lenA)-1)
-	var totalRange, sliceB SliceLike[byte, int]
 	if newFirstIdx < firstIdx {
-		totalRange = f.Slice(newFirstIdx, lastIdx)
-		sliceB = f.Slice(newFirstIdx, firstIdx-1)
+		f.recordErr(rotate(f.File, f.chunkSize(), int64(newFirstIdx), int64(firstIdx), int64(lastIdx)+1))
 	} else {
-		totalRange = f.Slice(firstIdx, (newFirstIdx+lenA)-1)
-		sliceB = f.Slice(lastIdx+1, (newFirstIdx+lenA)-1)
+		f.recordErr(rotate(f.File, f.chunkSize(), int64(firstIdx), int64(lastIdx)+1, int64(newFirstIdx+lenA)))
 	}
-	Reverse(sliceA)
-	Reverse(sliceB)
-	Reverse(totalRange)
 }
 
 // Return another SliceLike[T, I] that holds values in range [first, last]
 //
 // Analogous to slice[first:last+1]
 func (f FileAdapter) Slice(firstIdx int, lastIdx int) (slice SliceLike[byte, int]) {
+	if f.errState != nil {
+		f.errState = &fileAdapterErr{}
+	}
 	return &FileSliceAdapter{
 		FAdapter: f,
 		start:    firstIdx,
@@ -191,6 +206,7 @@ func (f FileAdapter) Len() int {
 func (f FileAdapter) LenIfValid() (l int, valid bool) {
 	stat, err := f.File.Stat()
 	if err != nil {
+		f.recordErr(err)
 		return 0, false
 	}
 	return int(stat.Size()), true
@@ -231,15 +247,13 @@ func (f FileAdapter) InsertSlotsAssumeCapacity(idx int, count int) (firstNewSlot
 	if !ok {
 		return 0, 0
 	}
-	ridx := l - 1
 	newSize := l + count
-	f.File.Truncate(int64(newSize))
-	widx := newSize - 1
-	for ridx >= idx {
-		Overwrite(f, ridx, widx)
-		ridx -= 1
-		widx -= 1
+	err := f.File.Truncate(int64(newSize))
+	if err != nil {
+		f.recordErr(err)
+		return 0, 0
 	}
+	f.recordErr(copyWithin(f.File, f.chunkSize(), int64(idx), int64(idx+count), int64(l-idx)))
 	firstNewSlot = idx
 	lastNewSlot = (firstNewSlot + count) - 1
 	return
@@ -258,7 +272,7 @@ func (f FileAdapter) AppendSlotsAssumeCapacity(count int) (firstNewSlot int, las
 	}
 	firstNewSlot = l
 	newSize := l + count
-	f.File.Truncate(int64(newSize))
+	f.recordErr(f.File.Truncate(int64(newSize)))
 	lastNewSlot = newSize - 1
 	return
 }
@@ -269,22 +283,21 @@ func (f FileAdapter) AppendSlotsAssumeCapacity(count int) (firstNewSlot int, las
 func (f FileAdapter) DeleteRange(firstRemovedIdx int, lastRemovedIdx int) {
 	stat, err := f.File.Stat()
 	if err != nil {
+		f.recordErr(err)
 		return
 	}
 	size := int(stat.Size())
-	ridx := lastRemovedIdx + 1
-	widx := firstRemovedIdx
-	for ridx < size {
-		Overwrite(f, ridx, widx)
-		ridx += 1
-		widx += 1
+	err = copyWithin(f.File, f.chunkSize(), int64(lastRemovedIdx+1), int64(firstRemovedIdx), int64(size-(lastRemovedIdx+1)))
+	if err != nil {
+		f.recordErr(err)
+		return
 	}
-	f.Truncate(int64(size - ((lastRemovedIdx - firstRemovedIdx) + 1)))
+	f.recordErr(f.Truncate(int64(size - ((lastRemovedIdx - firstRemovedIdx) + 1))))
 }
 
 // Reset list to an empty state. The list's capacity may or may not be retained.
 func (f FileAdapter) Clear() {
-	f.Truncate(0)
+	f.recordErr(f.Truncate(0))
 }
 
 // Return the total number of values the slice/list can hold
@@ -296,6 +309,186 @@ func (f FileAdapter) Cap() int {
 	return int(stat.Size())
 }
 
+// ErrorReporter
+
+// Return the first I/O error encountered by this adapter
+// since the last call to `ResetErr()`, or `nil` if none occurred
+func (f FileAdapter) Err() error {
+	if f.errState == nil {
+		return nil
+	}
+	return f.errState.err
+}
+
+// Clear any error recorded by this adapter
+func (f FileAdapter) ResetErr() {
+	if f.errState != nil {
+		f.errState.err = nil
+	}
+}
+
+func (f FileAdapter) recordErr(err error) {
+	if err == nil {
+		return
+	}
+	if f.errState == nil {
+		panic(err)
+	}
+	if f.errState.err == nil {
+		f.errState.err = err
+	}
+}
+
+// Bulk access
+
+// Copy the bytes from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`
+// with a single read
+//
+// Any error is recorded and can be retrieved with `Err()`
+func (f FileAdapter) GetRange(firstIdx int, lastIdx int, dst []byte) {
+	_, err := f.ReadAt(dst[:lastIdx-firstIdx+1], int64(firstIdx))
+	f.recordErr(err)
+}
+
+// Set the `len(src)` bytes starting at `firstIdx` to the bytes in `src`
+// with a single write
+//
+// Any error is recorded and can be retrieved with `Err()`
+func (f FileAdapter) SetRange(firstIdx int, src []byte) {
+	_, err := f.WriteAt(src, int64(firstIdx))
+	f.recordErr(err)
+}
+
+// Block-move engine
+
+func (f FileAdapter) chunkSize() int64 {
+	if f.ChunkSize <= 0 {
+		return DefaultFileChunkSize
+	}
+	return int64(f.ChunkSize)
+}
+
+// Any type the block-move engine can shift data within
+type readerWriterAt interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// Copy `n` bytes from offset `srcOff` to offset `destOff` in chunks,
+// working in whichever direction keeps overlapping ranges intact
+func copyWithin(rw readerWriterAt, chunkSize int64, srcOff int64, destOff int64, n int64) (err error) {
+	if n <= 0 || srcOff == destOff {
+		return nil
+	}
+	buf := make([]byte, min(chunkSize, n))
+	if destOff < srcOff {
+		var done int64
+		for done < n && err == nil {
+			chunk := buf[:min(int64(len(buf)), n-done)]
+			err = readWriteChunk(rw, chunk, srcOff+done, destOff+done)
+			done += int64(len(chunk))
+		}
+	} else {
+		remaining := n
+		for remaining > 0 && err == nil {
+			chunk := buf[:min(int64(len(buf)), remaining)]
+			remaining -= int64(len(chunk))
+			err = readWriteChunk(rw, chunk, srcOff+remaining, destOff+remaining)
+		}
+	}
+	return
+}
+
+func readWriteChunk(rw readerWriterAt, chunk []byte, srcOff int64, destOff int64) (err error) {
+	_, err = rw.ReadAt(chunk, srcOff)
+	if err != nil {
+		return
+	}
+	_, err = rw.WriteAt(chunk, destOff)
+	return
+}
+
+// Exchange the `n` bytes at offset `offA` with the `n` bytes at offset `offB`,
+// where the two ranges do not overlap
+func swapBlocks(rw readerWriterAt, chunkSize int64, offA int64, offB int64, n int64) (err error) {
+	size := min(chunkSize, n)
+	bufA := make([]byte, size)
+	bufB := make([]byte, size)
+	var done int64
+	for done < n && err == nil {
+		chunkA := bufA[:min(size, n-done)]
+		chunkB := bufB[:len(chunkA)]
+		_, err = rw.ReadAt(chunkA, offA+done)
+		if err != nil {
+			return
+		}
+		_, err = rw.ReadAt(chunkB, offB+done)
+		if err != nil {
+			return
+		}
+		_, err = rw.WriteAt(chunkB, offA+done)
+		if err != nil {
+			return
+		}
+		_, err = rw.WriteAt(chunkA, offB+done)
+		done += int64(len(chunkA))
+	}
+	return
+}
+
+// Exchange the adjacent byte ranges `[first, mid)` and `[mid, end)`
+//
+// If either range fits in a single chunk it is held in memory while the
+// other is shifted over, otherwise the ranges are rotated by repeated
+// chunked block swaps
+func rotate(rw readerWriterAt, chunkSize int64, first int64, mid int64, end int64) (err error) {
+	lenA := mid - first
+	lenB := end - mid
+	if lenA <= 0 || lenB <= 0 {
+		return nil
+	}
+	if min(lenA, lenB) <= chunkSize {
+		if lenA <= lenB {
+			held := make([]byte, lenA)
+			_, err = rw.ReadAt(held, first)
+			if err != nil {
+				return
+			}
+			err = copyWithin(rw, chunkSize, mid, first, lenB)
+			if err != nil {
+				return
+			}
+			_, err = rw.WriteAt(held, first+lenB)
+		} else {
+			held := make([]byte, lenB)
+			_, err = rw.ReadAt(held, mid)
+			if err != nil {
+				return
+			}
+			err = copyWithin(rw, chunkSize, first, first+lenB, lenA)
+			if err != nil {
+				return
+			}
+			_, err = rw.WriteAt(held, first)
+		}
+		return
+	}
+	for lenA > 0 && lenB > 0 && err == nil {
+		if lenA <= lenB {
+			err = swapBlocks(rw, chunkSize, first, mid, lenA)
+			first = mid
+			mid += lenA
+		} else {
+			err = swapBlocks(rw, chunkSize, mid-lenB, mid, lenB)
+			end = mid
+			mid -= lenB
+		}
+		lenA = mid - first
+		lenB = end - mid
+	}
+	return
+}
+
 // File aliases
 
 func (f FileAdapter) Chdir() error {
@@ -307,6 +500,7 @@ func (f FileAdapter) Chmod(mode os.FileMode) error {
 func (f FileAdapter) Chown(uid int, gid int) error {
 	return f.File.Chown(uid, gid)
 }
+
 func (f FileAdapter) Close() error {
 	return f.File.Close()
 }
@@ -371,7 +565,13 @@ func (f FileAdapter) WriteTo(w io.Writer) (n int64, err error) {
 	return f.File.WriteTo(w)
 }
 
+func (f FileAdapter) storage() (token any, known bool) {
+	return f.File, true
+}
+
 var _ ListLike[byte, int] = FileAdapter{}
+var _ ErrorReporter = FileAdapter{}
+var _ BulkSliceLike[byte, int] = FileAdapter{}
 var _ io.Reader = FileAdapter{}
 var _ io.Writer = FileAdapter{}
 var _ io.ReaderAt = FileAdapter{}
@@ -418,36 +618,22 @@ func (f *FileSliceAdapter) SplitRange(firstIdx int, lastIdx int) (middleIdx int)
 // Get the value at the provided index
 func (f *FileSliceAdapter) Get(idx int) (val byte) {
 	var b [1]byte
-	f.FAdapter.ReadAt(b[:], int64(f.start+idx))
+	_, err := f.FAdapter.ReadAt(b[:], int64(f.start+idx))
+	f.FAdapter.recordErr(err)
 	return b[0]
 }
 
 // Set the value at the provided index to the given value
 func (f *FileSliceAdapter) Set(idx int, val byte) {
 	var b [1]byte = [1]byte{val}
-	f.FAdapter.WriteAt(b[:], int64(f.start+idx))
+	_, err := f.FAdapter.WriteAt(b[:], int64(f.start+idx))
+	f.FAdapter.recordErr(err)
 }
 
-// Move only the data contained at `oldIdx` to `newIdx`,
-// overwriting the existing data at `newIdx`
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
 func (f *FileSliceAdapter) Move(oldIdx int, newIdx int) {
-	val := f.Get(oldIdx)
-	if newIdx < oldIdx {
-		prevIdx := oldIdx - 1
-		for oldIdx >= newIdx {
-			Overwrite(f, prevIdx, oldIdx)
-			oldIdx = prevIdx
-			prevIdx -= 1
-		}
-	} else {
-		nextIdx := oldIdx + 1
-		for oldIdx <= newIdx {
-			Overwrite(f, nextIdx, oldIdx)
-			oldIdx = nextIdx
-			nextIdx += 1
-		}
-	}
-	f.Set(newIdx, val)
+	f.FAdapter.Move(f.start+oldIdx, f.start+newIdx)
 }
 
 // Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
@@ -460,11 +646,7 @@ func (f *FileSliceAdapter) MoveRange(firstIdx int, lastIdx int, newFirstIdx int)
 //
 // Analogous to slice[first:last+1]
 func (f *FileSliceAdapter) Slice(firstIdx int, lastIdx int) (slice SliceLike[byte, int]) {
-	return &FileSliceAdapter{
-		FAdapter: f.FAdapter,
-		start:    firstIdx,
-		len:      (lastIdx - firstIdx) + 1,
-	}
+	return f.FAdapter.Slice(firstIdx, lastIdx)
 }
 
 // Return the first index in the slice.
@@ -546,7 +728,7 @@ func (f *FileSliceAdapter) Read(b []byte) (n int, err error) {
 
 func (f *FileSliceAdapter) WriteAt(b []byte, off int64) (n int, err error) {
 	maxOffset := min(f.len, int(off))
-	maxLen := f.len - maxOffset
+	maxLen := min(len(b), f.len-maxOffset)
 	n, err = f.FAdapter.WriteAt(b[:maxLen], int64(f.start+maxOffset))
 	if err == nil && n < len(b) {
 		err = io.EOF
@@ -555,7 +737,7 @@ func (f *FileSliceAdapter) WriteAt(b []byte, off int64) (n int, err error) {
 }
 func (f *FileSliceAdapter) ReadAt(b []byte, off int64) (n int, err error) {
 	maxOffset := min(f.len, int(off))
-	maxLen := f.len - maxOffset
+	maxLen := min(len(b), f.len-maxOffset)
 	n, err = f.FAdapter.ReadAt(b[:maxLen], int64(f.start+maxOffset))
 	if err == nil && n < len(b) {
 		err = io.EOF
@@ -563,7 +745,36 @@ func (f *FileSliceAdapter) ReadAt(b []byte, off int64) (n int, err error) {
 	return
 }
 
+// Return the first I/O error encountered by this slice
+// since the last call to `ResetErr()`, or `nil` if none occurred
+func (f *FileSliceAdapter) Err() error {
+	return f.FAdapter.Err()
+}
+
+// Clear any error recorded by this slice
+func (f *FileSliceAdapter) ResetErr() {
+	f.FAdapter.ResetErr()
+}
+
+// Copy the bytes from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`
+// with a single read
+func (f *FileSliceAdapter) GetRange(firstIdx int, lastIdx int, dst []byte) {
+	f.FAdapter.GetRange(f.start+firstIdx, f.start+lastIdx, dst)
+}
+
+// Set the `len(src)` bytes starting at `firstIdx` to the bytes in `src`
+// with a single write
+func (f *FileSliceAdapter) SetRange(firstIdx int, src []byte) {
+	f.FAdapter.SetRange(f.start+firstIdx, src)
+}
+
+func (f *FileSliceAdapter) storage() (token any, known bool) {
+	return f.FAdapter.storage()
+}
+
 var _ QueueLike[byte, int] = (*FileSliceAdapter)(nil)
+var _ ErrorReporter = (*FileSliceAdapter)(nil)
+var _ BulkSliceLike[byte, int] = (*FileSliceAdapter)(nil)
 var _ io.Reader = (*FileSliceAdapter)(nil)
 var _ io.ReaderAt = (*FileSliceAdapter)(nil)
 var _ io.WriterAt = (*FileSliceAdapter)(nil)
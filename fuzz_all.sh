@@ -89,10 +89,47 @@ else
     runfuzz Fuzz_InsertionSort_
     runfuzz Fuzz_SortedInsert_
     runfuzz Fuzz_SortedSearch_
+    runfuzz Fuzz_Bounds_
+    runfuzz Fuzz_SortedSet_
+    runfuzz Fuzz_SortedMultiSet_
+    runfuzz Fuzz_SetOps_
+    runfuzz Fuzz_Sort_
+    runfuzz Fuzz_StableSort_
+    runfuzz Fuzz_ExternalSort_
+    runfuzz Fuzz_Select_
+    runfuzz Fuzz_RadixSort_
+    runfuzz Fuzz_FastPaths_
+    runfuzz Fuzz_BulkSlice_
+    runfuzz Fuzz_Cursor_
+    runfuzz Fuzz_CursorUnsigned_
+    runfuzz Fuzz_SwizzleLinear_
+    runfuzz Fuzz_Heap_
+    runfuzz Fuzz_PriorityQueue_
+    runfuzz Fuzz_Partition_
+    runfuzz Fuzz_Unique_
+    runfuzz Fuzz_Random_
     cd implementation_test
     runfuzz Fuzz_SliceAdapter_
     runfuzz Fuzz_SliceAdapterIndirect_
     runfuzz Fuzz_FileAdapter_
+    runfuzz Fuzz_FileAdapterSmallChunks_
+    runfuzz Fuzz_FileAdapterErrors_
+    runfuzz Fuzz_BufferedFileAdapter_
+    runfuzz Fuzz_LinkedList_
+    runfuzz Fuzz_LinkedListStrings_
+    runfuzz Fuzz_RingBuffer_
+    runfuzz Fuzz_RingBufferStream_
+    runfuzz Fuzz_RecordView_
+    runfuzz Fuzz_Iterators_
+    runfuzz Fuzz_SortedIndexMap_
+    runfuzz Fuzz_ReverseSliceAdapterView_
+    runfuzz Fuzz_ReverseLinkedListView_
+    runfuzz Fuzz_StrideSliceAdapterView_
+    runfuzz Fuzz_StrideLinkedListView_
+    runfuzz Fuzz_WindowSliceAdapterView_
+    runfuzz Fuzz_WindowLinkedListView_
+    runfuzz Fuzz_ConcatView_
+    runfuzz Fuzz_MapListView_
 fi
 echo "~~~~~~FUZZ TESTS COMPLETE~~~~~~    TIME:    15s  30s  45s  60s  75s  90s  105s 120s 135s 150s 165s 180s"
 # RESULTS
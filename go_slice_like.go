@@ -2,5 +2,8 @@ package go_list_like
 
 type GoSliceLike[T any] interface {
 	// Return the underlying golang slice that holds the data
+	//
+	// If the implementation is also a `SliceLike`, index `i` must refer to `GoSlice()[i]`,
+	// as the helper functions use this to copy, fill and reverse whole ranges at once
 	GoSlice() []T
 }
@@ -0,0 +1,167 @@
+package go_list_like
+
+import (
+	"slices"
+	"testing"
+)
+
+func Fuzz_Heap_(f *testing.F) {
+	f.Add([]byte{}, []byte{0, 5, 0, 3, 0, 9, 1, 1, 1, 1})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, []byte{1, 0, 2, 2, 4, 3, 3, 0, 0, 7, 1})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, []byte{3, 0, 2, 5, 200, 1, 0, 77, 3, 2})
+	f.Fuzz(func(t *testing.T, initial []byte, ops []byte) {
+		for _, arity := range []int{2, 3, 5} {
+			slice := NewSliceAdapter(slices.Clone(initial))
+			checkHeapOps(t, "SliceAdapter", &slice, arity, initial, ops)
+			list := NewLinkedList(slices.Clone(initial))
+			checkHeapOps(t, "LinkedList", &list, arity, initial, ops)
+		}
+	})
+}
+
+// Applies `ops` to both the heap and a sorted reference slice: `0` pushes the next byte,
+// `1` pops, `2` sets the value at the position in the next byte and fixes it,
+// and `3` removes the value at the position in the next byte
+func checkHeapOps[L ListLike[byte, int]](t *testing.T, name string, list L, arity int, initial []byte, ops []byte) {
+	HeapInitWithArity(list, GreaterThanImplicit, arity)
+	expect := slices.Clone(initial)
+	slices.Sort(expect)
+	if !checkHeap(t, name, "HeapInitWithArity", list, arity, expect) {
+		return
+	}
+	for i := 0; i < len(ops); i += 1 {
+		op := ops[i] % 4
+		var arg byte
+		if op != 1 {
+			i += 1
+			if i >= len(ops) {
+				return
+			}
+			arg = ops[i]
+		}
+		switch op {
+		case 0:
+			HeapPushWithArity(list, arg, GreaterThanImplicit, arity)
+			pos, _ := slices.BinarySearch(expect, arg)
+			expect = slices.Insert(expect, pos, arg)
+		case 1:
+			val, ok := TryHeapPopWithArity(list, GreaterThanImplicit, arity)
+			if ok != (len(expect) > 0) {
+				t.Errorf("\nFAIL: %s arity %d TryHeapPopWithArity() ok\nEXP: %t\nGOT: %t", name, arity, len(expect) > 0, ok)
+				return
+			}
+			if !ok {
+				continue
+			}
+			if val != expect[0] {
+				t.Errorf("\nFAIL: %s arity %d TryHeapPopWithArity() val\nEXP: %d\nGOT: %d", name, arity, expect[0], val)
+				return
+			}
+			expect = expect[1:]
+		case 2, 3:
+			if len(expect) == 0 {
+				continue
+			}
+			idx := NthIdx(list, int(arg)%len(expect))
+			old := list.Get(idx)
+			oldPos, _ := slices.BinarySearch(expect, old)
+			expect = slices.Delete(expect, oldPos, oldPos+1)
+			if op == 2 {
+				list.Set(idx, arg)
+				HeapFixWithArity(list, idx, GreaterThanImplicit, arity)
+				pos, _ := slices.BinarySearch(expect, arg)
+				expect = slices.Insert(expect, pos, arg)
+			} else if val := HeapRemoveWithArity(list, idx, GreaterThanImplicit, arity); val != old {
+				t.Errorf("\nFAIL: %s arity %d HeapRemoveWithArity() val\nEXP: %d\nGOT: %d", name, arity, old, val)
+				return
+			}
+		}
+		if !checkHeap(t, name, "op", list, arity, expect) {
+			return
+		}
+	}
+}
+
+func checkHeap[L ListLike[byte, int]](t *testing.T, name string, opName string, list L, arity int, expect []byte) bool {
+	got := make([]byte, 0, list.Len())
+	DoActionOnAllItems(list, func(slice L, idx int, item byte) {
+		got = append(got, item)
+	})
+	for pos := 1; pos < len(got); pos += 1 {
+		if got[(pos-1)/arity] > got[pos] {
+			t.Errorf("\nFAIL: %s arity %d %s() heap property broken at position %d\nGOT: %v", name, arity, opName, pos, got)
+			return false
+		}
+	}
+	sorted := slices.Clone(got)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, expect) {
+		t.Errorf("\nFAIL: %s arity %d %s() values mismatch\nEXP: %v\nGOT: %v", name, arity, opName, expect, sorted)
+		return false
+	}
+	if len(got) > 0 && HeapPeek(list) != expect[0] {
+		t.Errorf("\nFAIL: %s arity %d %s() HeapPeek()\nEXP: %d\nGOT: %d", name, arity, opName, expect[0], HeapPeek(list))
+		return false
+	}
+	return true
+}
+
+func Fuzz_PriorityQueue_(f *testing.F) {
+	f.Add([]byte{}, []byte{5, 3, 9}, byte(2))
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, []byte{11, 1, 12, 0}, byte(4))
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, []byte{3, 3, 200, 4, 4, 4}, byte(3))
+	f.Fuzz(func(t *testing.T, initial []byte, pushes []byte, count byte) {
+		queue := NewPriorityQueueWithArity(slices.Clone(initial), GreaterThanImplicit, 2+int(count%3))
+		expect := slices.Clone(initial)
+		for _, b := range pushes {
+			queue.Push(b)
+			expect = append(expect, b)
+		}
+		slices.Sort(expect)
+		if val, ok := queue.Peek(); ok != (len(expect) > 0) || (ok && val != expect[0]) {
+			t.Errorf("\nFAIL: PriorityQueue.Peek()\nEXP: %v\nGOT: %d, %t", expect, val, ok)
+			return
+		}
+		buf := make([]byte, int(count)%(len(expect)+1))
+		bufSlice := NewSliceAdapter(buf)
+		n := DequeueToSlice(&queue, len(buf), &bufSlice)
+		if n != len(buf) || !slices.Equal(buf, expect[:n]) {
+			t.Errorf("\nFAIL: DequeueToSlice(PriorityQueue)\nEXP: %v\nGOT: %v", expect[:len(buf)], buf[:n])
+			return
+		}
+		expect = expect[n:]
+		// Pushing after a dequeue must leave the values unsorted until they are next read by index
+		queue.Push(count)
+		pos, _ := slices.BinarySearch(expect, count)
+		expect = slices.Insert(expect, pos, count)
+		Discard(&queue, 1)
+		expect = expect[1:]
+		for len(expect) > 0 {
+			val, ok := queue.Pop()
+			if !ok || val != expect[0] {
+				t.Errorf("\nFAIL: PriorityQueue.Pop()\nEXP: %v\nGOT: %d, %t", expect, val, ok)
+				return
+			}
+			expect = expect[1:]
+		}
+		if _, ok := queue.Pop(); ok {
+			t.Errorf("\nFAIL: PriorityQueue.Pop() on empty queue returned ok")
+		}
+		// Writing by index could break the priority order, so the queue is read-only as a `SliceLike`
+		queue.Push(count)
+		for name, write := range map[string]func(){
+			"Set":       func() { queue.Set(0, count) },
+			"Move":      func() { queue.Move(0, 0) },
+			"Slice.Set": func() { queue.Slice(0, 0).Set(0, count) },
+		} {
+			func() {
+				defer func() {
+					if err := recover(); err != ErrReadOnly {
+						t.Errorf("\nFAIL: PriorityQueue.%s()\nEXP: panic(%v)\nGOT: panic(%v)", name, ErrReadOnly, err)
+					}
+				}()
+				write()
+			}()
+		}
+	})
+}
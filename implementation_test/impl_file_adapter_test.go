@@ -1,6 +1,7 @@
 package implementation_test
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"strings"
@@ -21,6 +22,13 @@ func newFileAdapter(t *testing.T, data []byte) LL.FileAdapter {
 	return LL.NewFileAdapter(file)
 }
 
+// Uses a tiny chunk size so that multi-chunk block moves are exercised
+func newFileAdapterSmallChunks(t *testing.T, data []byte) LL.FileAdapter {
+	fa := newFileAdapter(t, data)
+	fa.ChunkSize = 2
+	return fa
+}
+
 func cleanupFileAdapter(t *testing.T, fa LL.FileAdapter) {
 	fa.Close()
 	os.Remove(fa.Name())
@@ -30,3 +38,99 @@ func Fuzz_FileAdapter_(f *testing.F) {
 	InitImplementationFuzz(f)
 	PerformListImplementationFuzz(f, "FileAdapter", newFileAdapter, cleanupFileAdapter)
 }
+
+func Fuzz_FileAdapterSmallChunks_(f *testing.F) {
+	InitImplementationFuzz(f)
+	PerformListImplementationFuzz(f, "FileAdapter{ChunkSize: 2}", newFileAdapterSmallChunks, cleanupFileAdapter)
+}
+
+func Fuzz_FileAdapterErrors_(f *testing.F) {
+	f.Add([]byte{1, 2, 3, 4}, 2)
+	f.Fuzz(func(t *testing.T, data []byte, idx int) {
+		if len(data) == 0 {
+			return
+		}
+		if idx < 0 {
+			idx = -idx
+		}
+		idx %= len(data)
+		fa := newFileAdapter(t, data)
+		val, err := LL.TryGetErr(fa, idx)
+		if err != nil || val != data[idx] {
+			t.Errorf("\nFAIL: FileAdapter TryGetErr on open file\nEXP: %d <nil>\nGOT: %d %v", data[idx], val, err)
+		}
+		_, err = LL.TryGetErr(fa, len(data))
+		if err != LL.ErrInvalidIndex {
+			t.Errorf("\nFAIL: FileAdapter TryGetErr out of bounds\nEXP: %v\nGOT: %v", LL.ErrInvalidIndex, err)
+		}
+		cleanupFileAdapter(t, fa)
+		fa.Get(idx)
+		if fa.Err() == nil {
+			t.Errorf("\nFAIL: FileAdapter Get on closed file did not record an error")
+		}
+		fa.ResetErr()
+		if fa.Err() != nil {
+			t.Errorf("\nFAIL: FileAdapter ResetErr did not clear error\nGOT: %v", fa.Err())
+		}
+		vals := LL.NewSliceAdapter(data)
+		_, _, err = LL.TryInsertErr(fa, 0, &vals)
+		if err == nil {
+			t.Errorf("\nFAIL: FileAdapter TryInsertErr on closed file did not return an error")
+		}
+		if LL.CheckErr(&vals) != nil {
+			t.Errorf("\nFAIL: SliceAdapter reported an error")
+		}
+		fa.ResetErr()
+		// Each adapter records its own errors, even over the same file
+		other := LL.NewFileAdapter(fa.File)
+		other.Set(idx, 0)
+		slice := other.Slice(0, len(data)-1).(*LL.FileSliceAdapter)
+		slice.Get(idx)
+		if other.Err() == nil || slice.Err() == nil || fa.Err() != nil {
+			t.Errorf("\nFAIL: FileAdapter errors shared between adapters over the same file\nEXP: error, error, <nil>\nGOT: %v, %v, %v", other.Err(), slice.Err(), fa.Err())
+		}
+		slice.ResetErr()
+		if other.Err() == nil {
+			t.Errorf("\nFAIL: FileSliceAdapter ResetErr cleared its parent's error")
+		}
+		// Without the constructor there is nowhere to record the error, so it must panic
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("\nFAIL: FileAdapter{File: file} Set on closed file did not panic")
+				}
+			}()
+			literal := LL.FileAdapter{File: fa.File}
+			literal.Set(idx, 0)
+		}()
+	})
+}
+
+// Uses tiny pages and a tiny cache so that page eviction and write-back are exercised
+func newBufferedFileAdapter(t *testing.T, data []byte) *LL.BufferedFileAdapter {
+	fa := newFileAdapter(t, data)
+	return LL.NewBufferedFileAdapterWithPages(fa.File, 4, 3)
+}
+
+// Verifies that closing the adapter writes every cached change back to the file
+func cleanupBufferedFileAdapter(t *testing.T, buf *LL.BufferedFileAdapter) {
+	expect := make([]byte, buf.Len())
+	buf.ReadAt(expect, 0)
+	name := buf.Name()
+	err := buf.Close()
+	if err != nil {
+		t.Errorf("\nFAIL: BufferedFileAdapter.Close() returned error: %s", err)
+	}
+	got, err := os.ReadFile(name)
+	if err != nil {
+		t.Errorf("\nFAIL: could not read back file '%s': %s", name, err)
+	} else if !bytes.Equal(expect, got) {
+		t.Errorf("\nFAIL: BufferedFileAdapter did not write back all changes\nEXP: %v\nGOT: %v", expect, got)
+	}
+	os.Remove(name)
+}
+
+func Fuzz_BufferedFileAdapter_(f *testing.F) {
+	InitImplementationFuzz(f)
+	PerformListImplementationFuzz(f, "BufferedFileAdapter", newBufferedFileAdapter, cleanupBufferedFileAdapter)
+}
@@ -0,0 +1,118 @@
+package implementation_test
+
+import (
+	"slices"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+func Fuzz_SortedIndexMap_(f *testing.F) {
+	f.Add([]byte{}, []byte{1, 0, 5, 2, 0, 7})
+	f.Add([]byte{5, 3, 9, 1, 7}, []byte{0, 2, 8, 3, 1, 2, 4, 0, 3, 5, 1, 2, 3})
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{5, 0, 3, 4, 4, 2, 3, 1, 7, 0, 1})
+	f.Fuzz(func(t *testing.T, initialData []byte, opData []byte) {
+		var sliceData []byte = slices.Clone(initialData)
+		slice := LL.NewSliceAdapterIndirect(&sliceData)
+		checkSortedIndexMaps(t, "SliceAdapterIndirect", slice, opData)
+		list := LL.NewLinkedList(slices.Clone(initialData))
+		checkSortedIndexMaps(t, "LinkedList", &list, opData)
+	})
+}
+
+func checkSortedIndexMaps[L LL.ListLike[byte, int]](t *testing.T, typeName string, list L, opData []byte) {
+	var ascData, descData []int
+	maps := []LL.SortedIndexMap[byte, int, L, LL.SliceAdapterIndirect[int]]{
+		LL.NewSortedIndexMap(list, LL.NewSliceAdapterIndirect(&ascData), LL.GreaterThanImplicit[byte]),
+		LL.NewSortedIndexMap(list, LL.NewSliceAdapterIndirect(&descData), LL.LessThanImplicit[byte]),
+	}
+	mapData := []*[]int{&ascData, &descData}
+	nthIdx := func(pos byte) int {
+		return list.NthNextIdx(list.FirstIdx(), int(pos)%list.Len())
+	}
+	opName := "NewSortedIndexMap"
+	verify := func() bool {
+		for i, m := range maps {
+			seen := make(map[int]bool)
+			for _, listIdx := range *mapData[i] {
+				if !list.IdxValid(listIdx) || seen[listIdx] {
+					t.Errorf("\nFAIL: %s map %d after %s: invalid or duplicate list index %d\nINDEXES: %v", typeName, i, opName, listIdx, *mapData[i])
+					return false
+				}
+				seen[listIdx] = true
+			}
+			if len(seen) != list.Len() {
+				t.Errorf("\nFAIL: %s map %d after %s: map holds %d indexes, list holds %d values", typeName, i, opName, len(seen), list.Len())
+				return false
+			}
+			vals := make([]byte, 0, list.Len())
+			for _, listIdx := range *mapData[i] {
+				vals = append(vals, list.Get(listIdx))
+			}
+			if !slices.IsSortedFunc(vals, func(a byte, b byte) int {
+				if m.GreaterThan(a, b) {
+					return 1
+				}
+				if m.GreaterThan(b, a) {
+					return -1
+				}
+				return 0
+			}) {
+				t.Errorf("\nFAIL: %s map %d after %s: values not sorted\nVALS: %v", typeName, i, opName, vals)
+				return false
+			}
+			for _, val := range vals {
+				_, listIdx, found := m.Search(list, val, LL.EqualImplicit[byte])
+				if !found || list.Get(listIdx) != val {
+					t.Errorf("\nFAIL: %s map %d after %s: Search(%d) failed", typeName, i, opName, val)
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if !verify() {
+		return
+	}
+	for len(opData) >= 3 {
+		op, a, b := opData[0]%6, opData[1], opData[2]
+		opData = opData[3:]
+		if list.Len() == 0 && op != 1 {
+			op = 1
+		}
+		switch op {
+		case 0:
+			opName = "SetWithMaps"
+			LL.SetWithMaps(list, nthIdx(a), b, maps)
+		case 1:
+			opName = "AppendVarWithMaps"
+			LL.AppendVarWithMaps(list, maps, a, b)
+		case 2:
+			opName = "InsertVarWithMaps"
+			LL.InsertVarWithMaps(list, nthIdx(a), maps, b, a)
+		case 3:
+			opName = "DeleteRangeWithMaps"
+			first := nthIdx(a)
+			last := list.NthNextIdx(first, int(b)%list.LenBetween(first, list.LastIdx()))
+			LL.DeleteRangeWithMaps(list, first, last, maps)
+		case 4:
+			opName = "MoveWithMaps"
+			LL.MoveWithMaps(list, nthIdx(a), nthIdx(b), maps)
+		case 5:
+			opName = "MoveRangeWithMaps"
+			if list.Len() < 2 {
+				continue
+			}
+			firstPos := int(a) % (list.Len() - 1)
+			rangeLen := 1 + int(b)%(list.Len()-firstPos-1)
+			newFirstPos := int(a^b) % (list.Len() - rangeLen + 1)
+			first := list.NthNextIdx(list.FirstIdx(), firstPos)
+			last := list.NthNextIdx(first, rangeLen-1)
+			newFirst := list.NthNextIdx(list.FirstIdx(), newFirstPos)
+			LL.MoveRangeWithMaps(list, first, last, newFirst, maps)
+		}
+		if !verify() {
+			return
+		}
+	}
+}
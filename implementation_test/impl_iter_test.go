@@ -0,0 +1,101 @@
+//go:build go1.23
+
+package implementation_test
+
+import (
+	"slices"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+func Fuzz_Iterators_(f *testing.F) {
+	f.Add([]byte{}, byte(0), byte(0))
+	f.Add([]byte{1, 2, 3, 4, 5}, byte(1), byte(3))
+	f.Add([]byte{9, 8, 7}, byte(2), byte(0))
+	f.Fuzz(func(t *testing.T, data []byte, rangeStart byte, rangeLen byte) {
+		list := LL.NewLinkedList(slices.Clone(data))
+		checkIterators(t, "LinkedList", &list, data, int(rangeStart), int(rangeLen))
+		adapter := LL.NewSliceAdapter(slices.Clone(data))
+		checkIterators(t, "SliceAdapter", &adapter, data, int(rangeStart), int(rangeLen))
+		ring := LL.NewRingBuffer(slices.Clone(data))
+		checkIterators(t, "RingBuffer", &ring, data, int(rangeStart), int(rangeLen))
+	})
+}
+
+func checkIterators[L LL.ListLike[byte, int]](t *testing.T, typeName string, list L, data []byte, rangeStart int, rangeLen int) {
+	var got []byte
+	for idx, val := range LL.All(list) {
+		if list.Get(idx) != val {
+			t.Errorf("\nFAIL: %s All() yielded index %d with value %d, but Get(%d) == %d", typeName, idx, val, idx, list.Get(idx))
+		}
+		got = append(got, val)
+	}
+	if !slices.Equal(data, got) {
+		t.Errorf("\nFAIL: %s All()\nEXP: %v\nGOT: %v", typeName, data, got)
+	}
+	got = slices.Collect(LL.Values(list))
+	if !slices.Equal(data, got) {
+		t.Errorf("\nFAIL: %s Values()\nEXP: %v\nGOT: %v", typeName, data, got)
+	}
+	got = got[:0]
+	for idx := range LL.Indexes(list) {
+		got = append(got, list.Get(idx))
+	}
+	if !slices.Equal(data, got) {
+		t.Errorf("\nFAIL: %s Indexes()\nEXP: %v\nGOT: %v", typeName, data, got)
+	}
+	expectBackward := slices.Clone(data)
+	slices.Reverse(expectBackward)
+	got = got[:0]
+	for _, val := range LL.Backward(list) {
+		got = append(got, val)
+	}
+	if !slices.Equal(expectBackward, got) {
+		t.Errorf("\nFAIL: %s Backward()\nEXP: %v\nGOT: %v", typeName, expectBackward, got)
+	}
+	if len(data) > 0 {
+		rangeStart %= len(data)
+		rangeLen = 1 + (rangeLen % (len(data) - rangeStart))
+		firstIdx := list.NthNextIdx(list.FirstIdx(), rangeStart)
+		lastIdx := list.NthNextIdx(firstIdx, rangeLen-1)
+		expectRange := data[rangeStart : rangeStart+rangeLen]
+		got = got[:0]
+		for _, val := range LL.AllInRange(list, firstIdx, lastIdx) {
+			got = append(got, val)
+		}
+		if !slices.Equal(expectRange, got) {
+			t.Errorf("\nFAIL: %s AllInRange(%d, %d)\nEXP: %v\nGOT: %v", typeName, firstIdx, lastIdx, expectRange, got)
+		}
+		got = got[:0]
+		for idx := range LL.IndexesInRange(list, firstIdx, lastIdx) {
+			got = append(got, list.Get(idx))
+		}
+		if !slices.Equal(expectRange, got) {
+			t.Errorf("\nFAIL: %s IndexesInRange(%d, %d)\nEXP: %v\nGOT: %v", typeName, firstIdx, lastIdx, expectRange, got)
+		}
+		got = got[:0]
+		for _, val := range LL.All(list) {
+			if len(got) == rangeLen {
+				break
+			}
+			got = append(got, val)
+		}
+		if !slices.Equal(data[:rangeLen], got) {
+			t.Errorf("\nFAIL: %s All() with early break\nEXP: %v\nGOT: %v", typeName, data[:rangeLen], got)
+		}
+	}
+	n, ok := LL.CollectInto(list, slices.Values(expectBackward))
+	if !ok || n != len(data) {
+		t.Errorf("\nFAIL: %s CollectInto()\nEXP: %d true\nGOT: %d %v", typeName, len(data), n, ok)
+	}
+	n, ok = LL.AppendSeq(list, slices.Values(data))
+	if !ok || n != len(data) {
+		t.Errorf("\nFAIL: %s AppendSeq()\nEXP: %d true\nGOT: %d %v", typeName, len(data), n, ok)
+	}
+	expectCollected := append(slices.Clone(expectBackward), data...)
+	got = slices.Collect(LL.Values(list))
+	if !slices.Equal(expectCollected, got) {
+		t.Errorf("\nFAIL: %s CollectInto() then AppendSeq()\nEXP: %v\nGOT: %v", typeName, expectCollected, got)
+	}
+}
@@ -0,0 +1,57 @@
+package implementation_test
+
+import (
+	"strconv"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+	"github.com/gabe-lee/go_list_like/lltest"
+)
+
+// Builds the list back-to-front so that node handles run in the
+// opposite order to the logical order of the items
+func newLinkedList(t *testing.T, data []byte) *LL.LinkedList[byte] {
+	list := LL.EmptyLinkedList[byte](len(data))
+	for i := len(data) - 1; i >= 0; i -= 1 {
+		first, _ := list.InsertSlotsAssumeCapacity(list.FirstIdx(), 1)
+		list.Set(first, data[i])
+	}
+	return &list
+}
+
+func newLinkedListStrings(t *testing.T, vals []string) *LL.LinkedList[string] {
+	list := LL.NewLinkedList(vals)
+	return &list
+}
+
+func genString(b byte) string {
+	return strconv.Itoa(int(b))
+}
+
+func Fuzz_LinkedList_(f *testing.F) {
+	InitImplementationFuzz(f)
+	PerformListImplementationFuzz(f, "LinkedList[byte]", newLinkedList, func(t *testing.T, list *LL.LinkedList[byte]) {})
+}
+
+func Fuzz_LinkedListStrings_(f *testing.F) {
+	lltest.FuzzList(f, "LinkedList[string]", genString, nil, newLinkedListStrings, nil)
+}
+
+func Test_LinkedListStrings_(t *testing.T) {
+	lltest.TestList(t, "LinkedList[string]", genString, LL.EqualImplicit[string], newLinkedListStrings, nil)
+}
+
+func Test_LinkedListSliceIncrementStart_(t *testing.T) {
+	list := LL.NewLinkedList([]byte{0, 1, 2, 3, 4, 5})
+	queue := list.Slice(list.NthNextIdx(list.FirstIdx(), 2), list.LastIdx()).(*LL.LinkedListSlice[byte])
+	for _, n := range []int{0, -1, -3} {
+		queue.IncrementStart(n)
+		if queue.Len() != 4 || queue.Get(queue.FirstIdx()) != 2 {
+			t.Errorf("\nFAIL: LinkedListSlice.IncrementStart(%d)\nEXP: first 2, len 4\nGOT: first %d, len %d", n, queue.Get(queue.FirstIdx()), queue.Len())
+		}
+	}
+	queue.IncrementStart(3)
+	if queue.Len() != 1 || queue.Get(queue.FirstIdx()) != 5 {
+		t.Errorf("\nFAIL: LinkedListSlice.IncrementStart(3)\nEXP: first 5, len 1\nGOT: first %d, len %d", queue.Get(queue.FirstIdx()), queue.Len())
+	}
+}
@@ -0,0 +1,124 @@
+package implementation_test
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+// Counts the bytes read and written one at a time, so that tests can check
+// that whole records are moved with `GetRange()` and `SetRange()` instead
+type countingFileAdapter struct {
+	LL.FileAdapter
+	nSingle *int
+}
+
+func (f countingFileAdapter) Get(idx int) (val byte) {
+	*f.nSingle += 1
+	return f.FileAdapter.Get(idx)
+}
+func (f countingFileAdapter) Set(idx int, val byte) {
+	*f.nSingle += 1
+	f.FileAdapter.Set(idx, val)
+}
+
+func readAllBytes(bytes LL.SliceLike[byte, int]) []byte {
+	data := make([]byte, 0, bytes.Len())
+	for i := bytes.FirstIdx(); bytes.IdxValid(i); i = bytes.NextIdx(i) {
+		data = append(data, bytes.Get(i))
+	}
+	return data
+}
+
+func Fuzz_RecordView_(f *testing.F) {
+	f.Add([]byte{}, uint32(5))
+	f.Add([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, uint32(0x01020304))
+	f.Add([]byte{255, 0, 0, 0, 0, 0, 0, 1, 9, 9, 9, 9}, uint32(1))
+	f.Fuzz(func(t *testing.T, data []byte, setVal uint32) {
+		newBytes := map[string]func() (LL.SliceLike[byte, int], func()){
+			"SliceAdapter": func() (LL.SliceLike[byte, int], func()) {
+				return newSliceAdapterPtr(t, slices.Clone(data)), func() {}
+			},
+			"RingBuffer": func() (LL.SliceLike[byte, int], func()) {
+				return newRingBuffer(t, data), func() {}
+			},
+			"LinkedList": func() (LL.SliceLike[byte, int], func()) {
+				return newLinkedList(t, data), func() {}
+			},
+			"FileAdapter": func() (LL.SliceLike[byte, int], func()) {
+				fa := newFileAdapter(t, data)
+				return fa, func() { cleanupFileAdapter(t, fa) }
+			},
+		}
+		for name, newBytes := range newBytes {
+			bytes, cleanup := newBytes()
+			checkRecordView(t, name+" LittleEndianCodec", bytes, setVal, LL.LittleEndianCodec[uint32]{}, binary.LittleEndian)
+			cleanup()
+			bytes, cleanup = newBytes()
+			checkRecordView(t, name+" BigEndianCodec", bytes, setVal, LL.BigEndianCodec[uint32]{}, binary.BigEndian)
+			cleanup()
+		}
+	})
+}
+
+func checkRecordView(t *testing.T, codecName string, bytes LL.SliceLike[byte, int], setVal uint32, codec LL.RecordCodec[uint32], order binary.ByteOrder) {
+	data := readAllBytes(bytes)
+	view := LL.NewRecordView[uint32, int](bytes, codec)
+	nRecords := len(data) / 4
+	if view.Len() != nRecords {
+		t.Errorf("\nFAIL: RecordView(%s).Len()\nEXP: %d\nGOT: %d", codecName, nRecords, view.Len())
+		return
+	}
+	expect := make([]uint32, nRecords)
+	for i := range expect {
+		expect[i] = order.Uint32(data[i*4:])
+		got := view.Get(i)
+		if got != expect[i] {
+			t.Errorf("\nFAIL: RecordView(%s).Get(%d)\nEXP: %d\nGOT: %d", codecName, i, expect[i], got)
+		}
+	}
+	if nRecords == 0 {
+		return
+	}
+	last := nRecords - 1
+	view.Set(last, setVal)
+	expect[last] = setVal
+	if got := order.Uint32(readAllBytes(bytes)[last*4:]); got != setVal {
+		t.Errorf("\nFAIL: RecordView(%s).Set(%d, %d) wrong encoding\nEXP: %d\nGOT: %d", codecName, last, setVal, setVal, got)
+	}
+	LL.InsertionSortImplicit(view)
+	slices.Sort(expect)
+	data = readAllBytes(bytes)
+	for i := range expect {
+		if got := order.Uint32(data[i*4:]); got != expect[i] {
+			t.Errorf("\nFAIL: RecordView(%s) InsertionSortImplicit()\nEXP: %v\nGOT: record %d == %d", codecName, expect, i, got)
+			return
+		}
+	}
+	idx, found := LL.SortedSearch(view, setVal, LL.EqualImplicit[uint32], LL.GreaterThanImplicit[uint32])
+	if !found || view.Get(idx) != setVal {
+		t.Errorf("\nFAIL: RecordView(%s) SortedSearch(%d)\nGOT: idx %d, found %v", codecName, setVal, idx, found)
+	}
+}
+
+func Test_RecordViewAccess_(t *testing.T) {
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	slice := LL.NewSliceAdapter(slices.Clone(data))
+	memView := LL.NewRecordView[uint32, int](&slice, LL.LittleEndianCodec[uint32]{})
+	allocs := testing.AllocsPerRun(100, func() {
+		memView.Set(1, memView.Get(0))
+	})
+	if allocs != 0 {
+		t.Errorf("\nFAIL: RecordView over SliceAdapter Get/Set allocated\nEXP: 0\nGOT: %v", allocs)
+	}
+	nSingle := 0
+	fa := newFileAdapter(t, data)
+	defer cleanupFileAdapter(t, fa)
+	fileView := LL.NewRecordView[uint32, int](countingFileAdapter{fa, &nSingle}, LL.LittleEndianCodec[uint32]{})
+	fileView.Set(1, fileView.Get(0)+1)
+	if got := fileView.Get(1); got != binary.LittleEndian.Uint32(data)+1 || nSingle != 0 {
+		t.Errorf("\nFAIL: RecordView over FileAdapter Get/Set\nEXP: %d with 0 single byte accesses\nGOT: %d with %d single byte accesses", binary.LittleEndian.Uint32(data)+1, got, nSingle)
+	}
+}
@@ -0,0 +1,54 @@
+package implementation_test
+
+import (
+	"slices"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+// Rotates the start of the buffer part way through the backing
+// memory so that the initial data wraps around the end
+func newRingBuffer(t *testing.T, data []byte) *LL.RingBuffer[byte] {
+	ring := LL.EmptyRingBuffer[byte](len(data) + 3)
+	for range 3 {
+		ring.PushBack(0)
+	}
+	ring.IncrementStart(2)
+	ring.PopFront()
+	for _, b := range data {
+		ring.PushBack(b)
+	}
+	return &ring
+}
+
+func Fuzz_RingBuffer_(f *testing.F) {
+	InitImplementationFuzz(f)
+	PerformListImplementationFuzz(f, "RingBuffer[byte]", newRingBuffer, func(t *testing.T, ring *LL.RingBuffer[byte]) {})
+}
+
+func Fuzz_RingBufferStream_(f *testing.F) {
+	f.Add([]byte{}, byte(1))
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, byte(3))
+	f.Add([]byte{129, 169, 201, 61, 232, 249, 94, 132, 135, 203, 186, 17}, byte(5))
+	f.Fuzz(func(t *testing.T, data []byte, chunk byte) {
+		chunkLen := max(int(chunk%16), 1)
+		ring := LL.EmptyRingBuffer[byte](chunkLen)
+		initCap := ring.Cap()
+		out := LL.EmptySliceAdapter[byte](len(data))
+		buf := make([]byte, chunkLen)
+		bufSlice := LL.NewSliceAdapter(buf)
+		for i := 0; i < len(data); i += chunkLen {
+			in := LL.NewSliceAdapter(data[i:min(i+chunkLen, len(data))])
+			LL.Append(&ring, &in)
+			n := LL.DequeueToSlice(&ring, ring.Len(), &bufSlice)
+			LL.AppendVar(&out, buf[:n]...)
+		}
+		if ring.Cap() != initCap {
+			t.Errorf("ring buffer reallocated while streaming: INIT CAP %d, FINAL CAP %d", initCap, ring.Cap())
+		}
+		if !slices.Equal(out.GoSlice(), data) {
+			t.Errorf("streamed data mismatch\n\tEXP: %v\n\tGOT: %v", data, out.GoSlice())
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package implementation_test
+
+import (
+	"slices"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+	"github.com/gabe-lee/go_list_like/lltest"
+)
+
+const viewJunk byte = 0xEE
+
+func reversed(data []byte) []byte {
+	data = slices.Clone(data)
+	slices.Reverse(data)
+	return data
+}
+
+// Places a junk value before, between, and after every value, so a stride view
+// with `step == 2` and `offset == 1` sees only the original values
+func interleaved(data []byte) []byte {
+	out := make([]byte, 0, (len(data)*2)+1)
+	out = append(out, viewJunk)
+	for _, b := range data {
+		out = append(out, b, viewJunk)
+	}
+	return out
+}
+
+func padded(data []byte) []byte {
+	out := []byte{viewJunk, viewJunk}
+	out = append(out, data...)
+	return append(out, viewJunk, viewJunk)
+}
+
+func newReverseSliceAdapterView(t *testing.T, data []byte) LL.ReverseListView[byte, int] {
+	slice := LL.NewSliceAdapter(reversed(data))
+	return LL.NewReverseListView[byte, int](&slice)
+}
+
+func newReverseLinkedListView(t *testing.T, data []byte) LL.ReverseListView[byte, int] {
+	return LL.NewReverseListView[byte, int](newLinkedList(t, reversed(data)))
+}
+
+func newStrideSliceAdapterView(t *testing.T, data []byte) LL.StrideView[byte, int] {
+	slice := LL.NewSliceAdapter(interleaved(data))
+	return LL.NewStrideView[byte, int](&slice, 2, 1)
+}
+
+func newStrideLinkedListView(t *testing.T, data []byte) LL.StrideView[byte, int] {
+	return LL.NewStrideView[byte, int](newLinkedList(t, interleaved(data)), 2, 1)
+}
+
+func newWindowSliceAdapterView(t *testing.T, data []byte) LL.WindowView[byte, int] {
+	slice := LL.NewSliceAdapter(padded(data))
+	return LL.NewWindowView[byte, int](&slice, 2, len(data)+1)
+}
+
+func newWindowLinkedListView(t *testing.T, data []byte) LL.WindowView[byte, int] {
+	if len(data) == 0 {
+		list := newLinkedList(t, data)
+		return LL.NewWindowView[byte, int](list, list.FirstIdx(), list.LastIdx())
+	}
+	list := newLinkedList(t, padded(data))
+	first := list.NthNextIdx(list.FirstIdx(), 2)
+	last := list.NthPrevIdx(list.LastIdx(), 2)
+	return LL.NewWindowView[byte, int](list, first, last)
+}
+
+// Splits the values between a slice adapter and a linked list, using a different
+// index type for the view than for its parts
+func newConcatView(t *testing.T, data []byte) LL.ConcatView[byte, int32] {
+	half := len(data) / 2
+	slice := LL.NewSliceAdapter(slices.Clone(data[:half]))
+	list := newLinkedList(t, data[half:])
+	return LL.NewConcatView[byte, int32](LL.NewConcatPart[byte, int](&slice), LL.NewConcatPart[byte, int](list))
+}
+
+type viewRecord struct {
+	key  byte
+	junk int
+}
+
+func newMapListView(t *testing.T, data []byte) LL.MapListView[byte, viewRecord, int] {
+	records := make([]viewRecord, len(data))
+	for i, b := range data {
+		records[i] = viewRecord{key: b, junk: -i}
+	}
+	slice := LL.NewSliceAdapter(records)
+	return LL.NewMapListView[byte, viewRecord, int](&slice, func(item viewRecord) byte {
+		return item.key
+	}, func(item viewRecord, val byte) viewRecord {
+		item.key = val
+		return item
+	})
+}
+
+func Fuzz_ReverseSliceAdapterView_(f *testing.F) {
+	lltest.FuzzList(f, "ReverseListView[SliceAdapter[byte]]", genByte, nil, newReverseSliceAdapterView, nil)
+}
+
+func Fuzz_ReverseLinkedListView_(f *testing.F) {
+	lltest.FuzzList(f, "ReverseListView[LinkedList[byte]]", genByte, nil, newReverseLinkedListView, nil)
+}
+
+func Fuzz_StrideSliceAdapterView_(f *testing.F) {
+	lltest.FuzzSlice(f, "StrideView[SliceAdapter[byte]]", genByte, nil, newStrideSliceAdapterView, nil)
+}
+
+func Fuzz_StrideLinkedListView_(f *testing.F) {
+	lltest.FuzzSlice(f, "StrideView[LinkedList[byte]]", genByte, nil, newStrideLinkedListView, nil)
+}
+
+func Fuzz_WindowSliceAdapterView_(f *testing.F) {
+	lltest.FuzzSlice(f, "WindowView[SliceAdapter[byte]]", genByte, nil, newWindowSliceAdapterView, nil)
+}
+
+func Fuzz_WindowLinkedListView_(f *testing.F) {
+	lltest.FuzzSlice(f, "WindowView[LinkedList[byte]]", genByte, nil, newWindowLinkedListView, nil)
+}
+
+func Fuzz_ConcatView_(f *testing.F) {
+	lltest.FuzzSlice(f, "ConcatView[byte]", genByte, nil, newConcatView, nil)
+}
+
+func Fuzz_MapListView_(f *testing.F) {
+	lltest.FuzzList(f, "MapListView[viewRecord]", genByte, nil, newMapListView, nil)
+}
+
+func Test_Views_(t *testing.T) {
+	lltest.TestList(t, "ReverseListView[SliceAdapter[byte]]", genByte, nil, newReverseSliceAdapterView, nil)
+	lltest.TestList(t, "ReverseListView[LinkedList[byte]]", genByte, nil, newReverseLinkedListView, nil)
+	lltest.TestSlice(t, "StrideView[SliceAdapter[byte]]", genByte, nil, newStrideSliceAdapterView, nil)
+	lltest.TestSlice(t, "StrideView[LinkedList[byte]]", genByte, nil, newStrideLinkedListView, nil)
+	lltest.TestSlice(t, "WindowView[SliceAdapter[byte]]", genByte, nil, newWindowSliceAdapterView, nil)
+	lltest.TestSlice(t, "WindowView[LinkedList[byte]]", genByte, nil, newWindowLinkedListView, nil)
+	lltest.TestSlice(t, "ConcatView[byte]", genByte, nil, newConcatView, nil)
+	lltest.TestList(t, "MapListView[viewRecord]", genByte, nil, newMapListView, nil)
+}
+
+// Views must read and write the underlying values in place
+func Test_ViewsShareValues_(t *testing.T) {
+	data := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	slice := LL.NewSliceAdapter(slices.Clone(data))
+	rev := LL.NewReverseView[byte, int](&slice)
+	rev.Set(0, 90)
+	stride := LL.NewStrideView[byte, int](&slice, 3, 1)
+	stride.Set(1, 40)
+	window := LL.NewWindowView[byte, int](&slice, 2, 5)
+	window.Set(0, 20)
+	other := LL.NewSliceAdapter([]byte{10, 11})
+	concat := LL.NewConcatView[byte, uint8](LL.NewConcatPart[byte, int](&slice), LL.NewConcatPart[byte, int](&other))
+	concat.Set(11, 111)
+	exp := []byte{0, 1, 20, 3, 40, 5, 6, 7, 8, 90}
+	if !slices.Equal(slice.GoSlice(), exp) || other.GoSlice()[1] != 111 {
+		t.Errorf("views did not write through to the underlying slices\n\tEXP: %v [10 111]\n\tGOT: %v %v", exp, slice.GoSlice(), other.GoSlice())
+	}
+	if stride.Len() != 3 || stride.Get(2) != 7 || window.Len() != 4 || window.Get(3) != 5 || concat.Len() != 12 || concat.Get(10) != 10 {
+		t.Errorf("views did not read the underlying values")
+	}
+}
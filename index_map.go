@@ -1,336 +1,295 @@
 package go_list_like
 
-// type SortedIndexMap[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]] struct {
-// 	GreaterThan func(a, b T) bool
-// 	Indexes     LL
-// }
+// A secondary index over a primary `ListLike[T, IDX]`, holding the indexes of the
+// primary list sorted by the values they refer to according to `GreaterThan`
+//
+// A map stays in sync with its primary list as long as every change to the list is made
+// through the `...WithMaps()` functions (`SetWithMaps()`, `InsertVarWithMaps()`, `DeleteRangeWithMaps()`, etc.).
+// Any number of maps with different comparators may be kept over the same list.
+//
+// If `list.ConsecutiveIndexesInOrder() == true`, the list's indexes are treated as positions, and the
+// indexes held by the map are shifted whenever values are inserted, deleted, or moved. Otherwise
+// the list's indexes are treated as handles that stay with their values (as with `LinkedList[T]`),
+// and only inserted or deleted indexes change
+type SortedIndexMap[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]] struct {
+	GreaterThan func(a, b T) bool
+	Indexes     LL
+}
 
-// func NewIndexMapsVar[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX], M SortedIndexMap[T, IDX, L, LL]](maps ...M) SliceAdapter[M] {
-// 	return NewSliceAdapter(maps)
-// }
+// Create a new `SortedIndexMap` using `indexes` as storage, filling it
+// with every index in `list` sorted by `greaterThan`
+func NewSortedIndexMap[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, indexes LL, greaterThan func(a, b T) bool) SortedIndexMap[T, IDX, L, LL] {
+	m := SortedIndexMap[T, IDX, L, LL]{
+		GreaterThan: greaterThan,
+		Indexes:     indexes,
+	}
+	m.Rebuild(list)
+	return m
+}
 
-// func NewIndexMap[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](indexes LL, greaterThan func(a, b T) bool) SortedIndexMap[T, IDX, L, LL] {
-// 	return SortedIndexMap[T, IDX, L, LL]{
-// 		GreaterThan: greaterThan,
-// 		Indexes:     indexes,
-// 	}
-// }
+// Discard all indexes held by the map and re-index every value in `list`
+func (m SortedIndexMap[T, IDX, L, LL]) Rebuild(list L) {
+	m.Indexes.Clear()
+	DoActionOnAllItems(list, func(list L, idx IDX, item T) {
+		AppendVar(m.Indexes, idx)
+	})
+	StableSort(m.Indexes, func(a, b IDX) bool {
+		return m.GreaterThan(list.Get(a), list.Get(b))
+	})
+}
 
-// func (m SortedIndexMap[T, IDX, L, LL]) rearrangeIndexesNoValueChange(list L, firstMoveDown, lastMoveDown, moveDownCount IDX, hasMoveDowns bool, firstMoveUp, lastMoveUp, moveUpCount IDX, hasMoveUps bool, firstDelete, lastDelete IDX, hasDeletes bool) {
-// 	var idx IDX
-// 	var thisIdxDone, firstDone, nextIsFirst bool
-// 	mIdx := m.Indexes.FirstIdx()
-// 	ok := m.Indexes.IdxValid(mIdx)
-// 	for ok {
-// 		idx = m.Indexes.Get(mIdx)
-// 		thisIdxDone = false
-// 		if list.ConsecutiveIndexesInOrder() {
-// 			if hasMoveDowns && idx >= firstMoveDown && idx <= lastMoveDown {
-// 				idx -= moveDownCount
-// 				m.Indexes.Set(mIdx, idx)
-// 			} else if hasMoveUps && idx >= firstMoveUp && idx <= lastMoveUp {
-// 				idx += moveUpCount
-// 				m.Indexes.Set(mIdx, idx)
-// 			} else if hasDeletes && idx >= firstDelete && idx <= lastDelete {
-// 				dIdx := mIdx
-// 				if !firstDone {
-// 					nextIsFirst = true
-// 				} else {
-// 					mIdx = m.Indexes.PrevIdx(mIdx)
-// 				}
-// 				DeleteRange(m.Indexes, dIdx, dIdx)
-// 			}
-// 		} else {
-// 			if hasMoveDowns {
-// 				DoActionOnItemsInRangeUntilFalse(list, firstMoveDown, lastMoveDown, func(list L, downIdx IDX, item T) bool {
-// 					if idx == downIdx {
-// 						idx -= moveDownCount
-// 						m.Indexes.Set(mIdx, idx)
-// 						thisIdxDone = true
-// 						if downIdx == firstMoveDown {
-// 							if downIdx == lastMoveDown {
-// 								hasMoveDowns = false
-// 							} else {
-// 								firstMoveDown = list.NextIdx(firstMoveDown)
-// 							}
-// 						} else if downIdx == lastMoveDown {
-// 							lastMoveDown = list.PrevIdx(lastMoveDown)
-// 						}
-// 						return false
-// 					}
-// 					return true
-// 				})
-// 			}
-// 			if hasMoveUps && !thisIdxDone {
-// 				DoActionOnItemsInRangeUntilFalse(list, firstMoveUp, lastMoveUp, func(list L, upIdx IDX, item T) bool {
-// 					if idx == upIdx {
-// 						idx += moveUpCount
-// 						m.Indexes.Set(mIdx, idx)
-// 						thisIdxDone = true
-// 						if upIdx == firstMoveUp {
-// 							if upIdx == lastMoveUp {
-// 								hasMoveUps = false
-// 							} else {
-// 								firstMoveUp = list.NextIdx(firstMoveUp)
-// 							}
-// 						} else if upIdx == lastMoveUp {
-// 							lastMoveUp = list.PrevIdx(lastMoveUp)
-// 						}
-// 						return false
-// 					}
-// 					return true
-// 				})
-// 			}
-// 			if hasDeletes && !thisIdxDone {
-// 				DoActionOnItemsInRangeUntilFalse(list, firstDelete, lastDelete, func(list L, delIdx IDX, item T) bool {
-// 					if idx == delIdx {
-// 						dIdx := mIdx
-// 						if !firstDone {
-// 							nextIsFirst = true
-// 						} else {
-// 							mIdx = m.Indexes.PrevIdx(mIdx)
-// 						}
-// 						DeleteRange(m.Indexes, dIdx, dIdx)
-// 						thisIdxDone = true
-// 						if delIdx == firstDelete {
-// 							if delIdx == lastDelete {
-// 								hasDeletes = false
-// 							} else {
-// 								firstDelete = list.NextIdx(firstDelete)
-// 							}
-// 						} else if delIdx == lastDelete {
-// 							lastDelete = list.PrevIdx(lastDelete)
-// 						}
-// 						return false
-// 					}
-// 					return true
-// 				})
-// 			}
-// 		}
-// 		ok = mIdx != m.Indexes.LastIdx()
-// 		if nextIsFirst {
-// 			mIdx = m.Indexes.FirstIdx()
-// 			nextIsFirst = false
-// 		} else {
-// 			mIdx = m.Indexes.NextIdx(mIdx)
-// 			firstDone = true
-// 		}
-// 		ok = ok && m.Indexes.IdxValid(mIdx)
-// 	}
-// }
+// Find a value in `list` using the map's sort order
+//
+// Returns the index in the map where the value was found, and the index in `list` it refers to
+func (m SortedIndexMap[T, IDX, L, LL]) Search(list L, val T, equalOrder func(a, b T) bool) (mapIdx IDX, listIdx IDX, found bool) {
+	mapIdx, found = SortedSearch(m.Indexes, val, func(a IDX, b T) bool {
+		return equalOrder(list.Get(a), b)
+	}, func(a IDX, b T) bool {
+		return m.GreaterThan(list.Get(a), b)
+	})
+	if found {
+		listIdx = m.Indexes.Get(mapIdx)
+	}
+	return
+}
 
-// // func (m IndexMap[T, IDX, S, L]) ShiftIndexUp(slice S, oldMovedIndex, newMovedIndex IDX) (mappedIdx IDX, found bool) {
-// // 	var maxCount IDX = slice.LenBetween(oldMovedIndex, newMovedIndex)
-// // 	var count IDX = 0
-// // 	found = false
-// // 	i := m.Indexes.FirstIdx()
-// // 	ok := m.Indexes.IdxValid(i)
-// // 	for ok {
-// // 		v := m.Indexes.Get(i)
-// // 		if v == oldMovedIndex {
-// // 			found = true
-// // 			mappedIdx = i
-// // 			if count >= maxCount && found {
-// // 				break
-// // 			}
-// // 		} else if v > oldMovedIndex && v <= newMovedIndex {
-// // 			SetSubtract(m.Indexes, i, 1)
-// // 			count += 1
-// // 			if count >= maxCount && found {
-// // 				break
-// // 			}
-// // 		}
-// // 		i = m.Indexes.NextIdx(i)
-// // 		ok = m.Indexes.IdxValid(i)
-// // 	}
-// // 	if found {
-// // 		m.Indexes.Set(mappedIdx, newMovedIndex)
-// // 	}
-// // 	return
-// // }
+func (m SortedIndexMap[T, IDX, L, LL]) TryGetVal(list L, mapIdx IDX) (val T, listIdx IDX, ok bool) {
+	ok = m.Indexes.IdxValid(mapIdx)
+	if !ok {
+		return
+	}
+	listIdx = m.Indexes.Get(mapIdx)
+	ok = list.IdxValid(listIdx)
+	if !ok {
+		return
+	}
+	val = list.Get(listIdx)
+	return
+}
+func (m SortedIndexMap[T, IDX, L, LL]) GetVal(list L, mapIdx IDX) (val T, listIdx IDX) {
+	listIdx = m.Indexes.Get(mapIdx)
+	val = list.Get(listIdx)
+	return
+}
 
-// // func (m IndexMap[T, IDX, S, L]) ShiftIndexDown(slice S, oldMovedIndex, newMovedIndex IDX) (mappedIdx IDX, found bool) {
-// // 	var maxCount IDX = slice.LenBetween(newMovedIndex, oldMovedIndex)
-// // 	var count IDX = 0
-// // 	found = false
-// // 	i := m.Indexes.FirstIdx()
-// // 	ok := m.Indexes.IdxValid(i)
-// // 	for ok {
-// // 		v := m.Indexes.Get(i)
-// // 		if v == oldMovedIndex {
-// // 			found = true
-// // 			mappedIdx = i
-// // 			if count >= maxCount && found {
-// // 				break
-// // 			}
-// // 		} else if v < oldMovedIndex && v >= newMovedIndex {
-// // 			SetSubtract(m.Indexes, i, 1)
-// // 			count += 1
-// // 			if count >= maxCount && found {
-// // 				break
-// // 			}
-// // 		}
-// // 		i = m.Indexes.NextIdx(i)
-// // 		ok = m.Indexes.IdxValid(i)
-// // 	}
-// // 	if found {
-// // 		m.Indexes.Set(mappedIdx, newMovedIndex)
-// // 	}
-// // 	return
-// // }
+// Return the index in the map that holds `listIdx`
+func (m SortedIndexMap[T, IDX, L, LL]) findListIdx(listIdx IDX) (mapIdx IDX, found bool) {
+	mapIdx = m.Indexes.FirstIdx()
+	ok := m.Indexes.IdxValid(mapIdx)
+	for ok {
+		if m.Indexes.Get(mapIdx) == listIdx {
+			return mapIdx, true
+		}
+		mapIdx = m.Indexes.NextIdx(mapIdx)
+		ok = m.Indexes.IdxValid(mapIdx)
+	}
+	return
+}
 
-// // func (m ListIndexMap[T, IDX, L, LL]) DeleteIndexesAndMoveOthersDown(list L, Sli) {
-// // 	var maxCount IDX = list.LenBetween(deleteIdx, list.LastIdx())
-// // 	var count IDX = 0
-// // 	i := m.Indexes.FirstIdx()
-// // 	ok := m.Indexes.IdxValid(i)
-// // 	for ok {
-// // 		v := m.Indexes.Get(i)
-// // 		if v >= startIdx {
-// // 			SetSubtract(m.Indexes, i, 1)
-// // 			count += 1
-// // 			if count >= maxCount {
-// // 				break
-// // 			}
-// // 		}
-// // 		i = m.Indexes.NextIdx(i)
-// // 		ok = m.Indexes.IdxValid(i)
-// // 	}
-// // }
+// Replace every list index held by the map with the result of `remap`,
+// removing it from the map if `keep == false`
+//
+// Because only list indexes change (and not the values they refer to), the map remains sorted
+func (m SortedIndexMap[T, IDX, L, LL]) remapIndexes(remap func(listIdx IDX) (newListIdx IDX, keep bool)) {
+	var kept []IDX
+	removed := false
+	DoActionOnAllItems(m.Indexes, func(indexes LL, mapIdx IDX, listIdx IDX) {
+		newListIdx, keep := remap(listIdx)
+		if !keep {
+			removed = true
+			return
+		}
+		kept = append(kept, newListIdx)
+		if !removed && newListIdx != listIdx {
+			indexes.Set(mapIdx, newListIdx)
+		}
+	})
+	if removed {
+		m.Indexes.Clear()
+		AppendVar(m.Indexes, kept...)
+	}
+}
 
-// // func (m IndexMap[T, IDX, S, L]) MoveIndexesUp(slice S, startIdx IDX) {
-// // 	var maxCount IDX = slice.Len() - startIdx
-// // 	var count IDX = 0
-// // 	i := m.Indexes.FirstIdx()
-// // 	ok := m.Indexes.IdxValid(i)
-// // 	for ok {
-// // 		v := m.Indexes.Get(i)
-// // 		if v >= startIdx {
-// // 			SetAdd(m.Indexes, i, 1)
-// // 			count += 1
-// // 			if count >= maxCount {
-// // 				break
-// // 			}
-// // 		}
-// // 		i = m.Indexes.NextIdx(i)
-// // 		ok = m.Indexes.IdxValid(i)
-// // 	}
-// // }
+// Add the list indexes `firstNewIdx` through `lastNewIdx` to the map, after they
+// have been inserted into `list` and their values set
+func (m SortedIndexMap[T, IDX, L, LL]) afterInsert(list L, firstNewIdx IDX, lastNewIdx IDX) {
+	if list.ConsecutiveIndexesInOrder() {
+		count := (lastNewIdx - firstNewIdx) + 1
+		m.remapIndexes(func(listIdx IDX) (IDX, bool) {
+			if listIdx >= firstNewIdx {
+				return listIdx + count, true
+			}
+			return listIdx, true
+		})
+	}
+	equalOrder := func(a IDX, b T) bool {
+		aVal := list.Get(a)
+		return !m.GreaterThan(aVal, b) && !m.GreaterThan(b, aVal)
+	}
+	greaterThan := func(a IDX, b T) bool {
+		return m.GreaterThan(list.Get(a), b)
+	}
+	DoActionOnItemsInRange(list, firstNewIdx, lastNewIdx, func(list L, newIdx IDX, item T) {
+		if m.Indexes.Len() == 0 {
+			AppendVar(m.Indexes, newIdx)
+			return
+		}
+		mapIdx, append := SortedInsertIndex(m.Indexes, item, equalOrder, greaterThan)
+		if append {
+			AppendVar(m.Indexes, newIdx)
+		} else {
+			InsertVar(m.Indexes, mapIdx, newIdx)
+		}
+	})
+}
 
-// // func (m IndexMap[T, IDX, S, L]) MoveIndexesDown(slice S, startIdx IDX) {
-// // 	var maxCount IDX = slice.Len() - startIdx
-// // 	var count IDX = 0
-// // 	i := m.Indexes.FirstIdx()
-// // 	ok := m.Indexes.IdxValid(i)
-// // 	for ok {
-// // 		v := m.Indexes.Get(i)
-// // 		if v >= startIdx {
-// // 			SetSubtract(m.Indexes, i, 1)
-// // 			count += 1
-// // 			if count >= maxCount {
-// // 				break
-// // 			}
-// // 		}
-// // 		i = m.Indexes.NextIdx(i)
-// // 		ok = m.Indexes.IdxValid(i)
-// // 	}
-// // }
+// Remove the list indexes `firstDeletedIdx` through `lastDeletedIdx` from the map,
+// before they are deleted from `list`
+func (m SortedIndexMap[T, IDX, L, LL]) beforeDelete(list L, firstDeletedIdx IDX, lastDeletedIdx IDX) {
+	if list.ConsecutiveIndexesInOrder() {
+		count := (lastDeletedIdx - firstDeletedIdx) + 1
+		m.remapIndexes(func(listIdx IDX) (IDX, bool) {
+			if listIdx > lastDeletedIdx {
+				return listIdx - count, true
+			}
+			return listIdx, listIdx < firstDeletedIdx
+		})
+		return
+	}
+	deleted := make(map[IDX]struct{})
+	DoActionOnItemsInRange(list, firstDeletedIdx, lastDeletedIdx, func(list L, idx IDX, item T) {
+		deleted[idx] = struct{}{}
+	})
+	m.remapIndexes(func(listIdx IDX) (IDX, bool) {
+		_, isDeleted := deleted[listIdx]
+		return listIdx, !isDeleted
+	})
+}
 
-// // func (m IndexMap[T, IDX, S, L]) TryResortAltered(slice S, mapIdx IDX) (ok bool) {
-// // 	var v, vv T
-// // 	var i, ii IDX
-// // 	var j IDX
-// // 	var sorted bool
-// // 	i = mapIdx
-// // 	v, j, ok = m.TryGetVal(slice, i)
-// // 	if !ok {
-// // 		return
-// // 	}
-// // 	ii = m.Indexes.PrevIdx(i)
-// // 	vv, _, ok = m.TryGetVal(slice, ii)
-// // 	for ok && m.GreaterThan(vv, v) {
-// // 		sorted = true
-// // 		Overwrite(m.Indexes, ii, i)
-// // 		i = ii
-// // 		ii = m.Indexes.PrevIdx(ii)
-// // 		vv, _, ok = m.TryGetVal(slice, ii)
-// // 	}
-// // 	if !sorted {
-// // 		i = mapIdx
-// // 		ii = m.Indexes.NextIdx(i)
-// // 		vv, _, ok = m.TryGetVal(slice, ii)
-// // 		for ok && m.GreaterThan(v, vv) {
-// // 			sorted = true
-// // 			Overwrite(m.Indexes, ii, i)
-// // 			i = ii
-// // 			ii = m.Indexes.NextIdx(ii)
-// // 			vv, _, ok = m.TryGetVal(slice, ii)
-// // 		}
-// // 	}
-// // 	m.Indexes.Set(i, j)
-// // 	ok = true
-// // 	return
-// // }
+// Shift the list indexes held by the map to account for `list.MoveRange(firstIdx, lastIdx, newFirstIdx)`
+func (m SortedIndexMap[T, IDX, L, LL]) beforeMoveRange(list L, firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	if !list.ConsecutiveIndexesInOrder() || firstIdx == newFirstIdx {
+		return
+	}
+	lenA := (lastIdx - firstIdx) + 1
+	if newFirstIdx < firstIdx {
+		m.remapIndexes(func(listIdx IDX) (IDX, bool) {
+			if listIdx >= firstIdx && listIdx <= lastIdx {
+				return newFirstIdx + (listIdx - firstIdx), true
+			}
+			if listIdx >= newFirstIdx && listIdx < firstIdx {
+				return listIdx + lenA, true
+			}
+			return listIdx, true
+		})
+		return
+	}
+	newLastIdx := newFirstIdx + lenA - 1
+	m.remapIndexes(func(listIdx IDX) (IDX, bool) {
+		if listIdx >= firstIdx && listIdx <= lastIdx {
+			return newFirstIdx + (listIdx - firstIdx), true
+		}
+		if listIdx > lastIdx && listIdx <= newLastIdx {
+			return listIdx - lenA, true
+		}
+		return listIdx, true
+	})
+}
 
-// func (m SortedIndexMap[T, IDX, L, LL]) resortAltered(list L, mapIdx IDX) {
-// 	var v, vv T
-// 	var i, ii IDX
-// 	var j IDX
-// 	var ok bool
-// 	var sorted bool
-// 	i = mapIdx
-// 	v, j = m.GetVal(list, i)
-// 	ii = m.Indexes.PrevIdx(i)
-// 	ok = m.Indexes.IdxValid(ii)
-// 	if ok {
-// 		vv, _ = m.GetVal(list, ii)
-// 		for ok && m.GreaterThan(vv, v) {
-// 			sorted = true
-// 			Overwrite(m.Indexes, ii, i)
-// 			i = ii
-// 			ii = m.Indexes.PrevIdx(ii)
-// 			ok = m.Indexes.IdxValid(ii)
-// 			if ok {
-// 				vv, _ = m.GetVal(list, ii)
-// 			}
-// 		}
-// 	}
-// 	if !sorted {
-// 		i = mapIdx
-// 		ii = m.Indexes.NextIdx(i)
-// 		ok = m.Indexes.IdxValid(ii)
-// 		if ok {
-// 			vv, _ = m.GetVal(list, ii)
-// 			for ok && m.GreaterThan(v, vv) {
-// 				sorted = true
-// 				Overwrite(m.Indexes, ii, i)
-// 				i = ii
-// 				ii = m.Indexes.NextIdx(ii)
-// 				ok = m.Indexes.IdxValid(ii)
-// 				if ok {
-// 					vv, _ = m.GetVal(list, ii)
-// 				}
-// 			}
-// 		}
-// 	}
-// 	m.Indexes.Set(i, j)
-// }
+// Restore the sort order of the map after the value at `listIdx` was changed
+func (m SortedIndexMap[T, IDX, L, LL]) afterSet(list L, listIdx IDX) {
+	mapIdx, found := m.findListIdx(listIdx)
+	if found {
+		m.resortAltered(list, mapIdx)
+	}
+}
 
-// func (m SortedIndexMap[T, IDX, S, L]) TryGetVal(slice S, mapIdx IDX) (val T, idx IDX, ok bool) {
-// 	ok = m.Indexes.IdxValid(mapIdx)
-// 	if !ok {
-// 		return
-// 	}
-// 	idx = m.Indexes.Get(mapIdx)
-// 	ok = slice.IdxValid(idx)
-// 	if !ok {
-// 		return
-// 	}
-// 	val = slice.Get(idx)
-// 	return
-// }
-// func (m SortedIndexMap[T, IDX, S, L]) GetVal(slice S, mapIdx IDX) (val T, idx IDX) {
-// 	idx = m.Indexes.Get(mapIdx)
-// 	val = slice.Get(idx)
-// 	return
-// }
+func (m SortedIndexMap[T, IDX, L, LL]) resortAltered(list L, mapIdx IDX) {
+	var v, vv T
+	var i, ii IDX
+	var j IDX
+	var ok bool
+	var sorted bool
+	i = mapIdx
+	v, j = m.GetVal(list, i)
+	ii = m.Indexes.PrevIdx(i)
+	ok = m.Indexes.IdxValid(ii)
+	if ok {
+		vv, _ = m.GetVal(list, ii)
+		for ok && m.GreaterThan(vv, v) {
+			sorted = true
+			Overwrite(m.Indexes, ii, i)
+			i = ii
+			ii = m.Indexes.PrevIdx(ii)
+			ok = m.Indexes.IdxValid(ii)
+			if ok {
+				vv, _ = m.GetVal(list, ii)
+			}
+		}
+	}
+	if !sorted {
+		i = mapIdx
+		ii = m.Indexes.NextIdx(i)
+		ok = m.Indexes.IdxValid(ii)
+		if ok {
+			vv, _ = m.GetVal(list, ii)
+			for ok && m.GreaterThan(v, vv) {
+				Overwrite(m.Indexes, ii, i)
+				i = ii
+				ii = m.Indexes.NextIdx(ii)
+				ok = m.Indexes.IdxValid(ii)
+				if ok {
+					vv, _ = m.GetVal(list, ii)
+				}
+			}
+		}
+	}
+	m.Indexes.Set(i, j)
+}
+
+// Wrapper functions
+
+func SetWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, idx IDX, val T, maps []SortedIndexMap[T, IDX, L, LL]) {
+	list.Set(idx, val)
+	for _, m := range maps {
+		m.afterSet(list, idx)
+	}
+}
+func AppendVarWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, maps []SortedIndexMap[T, IDX, L, LL], vals ...T) (firstAppendedIdx IDX, lastAppendedIdx IDX) {
+	if len(vals) == 0 {
+		return
+	}
+	firstAppendedIdx, lastAppendedIdx = AppendVar(list, vals...)
+	for _, m := range maps {
+		m.afterInsert(list, firstAppendedIdx, lastAppendedIdx)
+	}
+	return
+}
+func InsertVarWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, idx IDX, maps []SortedIndexMap[T, IDX, L, LL], vals ...T) (firstInsertedIdx IDX, lastInsertedIdx IDX) {
+	if len(vals) == 0 {
+		return
+	}
+	firstInsertedIdx, lastInsertedIdx = InsertVar(list, idx, vals...)
+	for _, m := range maps {
+		m.afterInsert(list, firstInsertedIdx, lastInsertedIdx)
+	}
+	return
+}
+func DeleteRangeWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, firstDeletedIdx IDX, lastDeletedIdx IDX, maps []SortedIndexMap[T, IDX, L, LL]) {
+	for _, m := range maps {
+		m.beforeDelete(list, firstDeletedIdx, lastDeletedIdx)
+	}
+	list.DeleteRange(firstDeletedIdx, lastDeletedIdx)
+}
+func MoveWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, oldIdx IDX, newIdx IDX, maps []SortedIndexMap[T, IDX, L, LL]) {
+	for _, m := range maps {
+		m.beforeMoveRange(list, oldIdx, oldIdx, newIdx)
+	}
+	list.Move(oldIdx, newIdx)
+}
+func MoveRangeWithMaps[T any, IDX Integer, L ListLike[T, IDX], LL ListLike[IDX, IDX]](list L, firstIdx IDX, lastIdx IDX, newFirstIdx IDX, maps []SortedIndexMap[T, IDX, L, LL]) {
+	for _, m := range maps {
+		m.beforeMoveRange(list, firstIdx, lastIdx, newFirstIdx)
+	}
+	list.MoveRange(firstIdx, lastIdx, newFirstIdx)
+}
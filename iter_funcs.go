@@ -0,0 +1,127 @@
+//go:build go1.23
+
+// These functions depend on the standard `iter` package, so they are only compiled with Go 1.23 or newer
+// even though the module declares `go 1.22`. See the package documentation
+
+package go_list_like
+
+import "iter"
+
+// Return an iterator over every index and value in the slice, in order
+//
+//	for idx, val := range All(slice) { ... }
+func All[T any, IDX Integer, S SliceLike[T, IDX]](slice S) iter.Seq2[IDX, T] {
+	return func(yield func(IDX, T) bool) {
+		idx := slice.FirstIdx()
+		ok := slice.IdxValid(idx)
+		for ok {
+			if !yield(idx, slice.Get(idx)) {
+				return
+			}
+			idx = slice.NextIdx(idx)
+			ok = slice.IdxValid(idx)
+		}
+	}
+}
+
+// Return an iterator over every index and value between (and including) `firstIdx` and `lastIdx`, in order
+func AllInRange[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX) iter.Seq2[IDX, T] {
+	return func(yield func(IDX, T) bool) {
+		idx := firstIdx
+		ok := slice.IdxValid(idx)
+		for ok {
+			if !yield(idx, slice.Get(idx)) {
+				return
+			}
+			ok = idx != lastIdx
+			idx = slice.NextIdx(idx)
+			ok = ok && slice.IdxValid(idx)
+		}
+	}
+}
+
+// Return an iterator over every index and value in the slice, in reverse order
+func Backward[T any, IDX Integer, S SliceLike[T, IDX]](slice S) iter.Seq2[IDX, T] {
+	return func(yield func(IDX, T) bool) {
+		idx := slice.LastIdx()
+		ok := slice.IdxValid(idx)
+		for ok {
+			if !yield(idx, slice.Get(idx)) {
+				return
+			}
+			idx = slice.PrevIdx(idx)
+			ok = slice.IdxValid(idx)
+		}
+	}
+}
+
+// Return an iterator over every value in the slice, in order
+func Values[T any, IDX Integer, S SliceLike[T, IDX]](slice S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range All(slice) {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator over every index in the slice, in order
+//
+// Values are not read from the slice
+func Indexes[T any, IDX Integer, S SliceLike[T, IDX]](slice S) iter.Seq[IDX] {
+	return func(yield func(IDX) bool) {
+		idx := slice.FirstIdx()
+		ok := slice.IdxValid(idx)
+		for ok {
+			if !yield(idx) {
+				return
+			}
+			idx = slice.NextIdx(idx)
+			ok = slice.IdxValid(idx)
+		}
+	}
+}
+
+// Return an iterator over every index between (and including) `firstIdx` and `lastIdx`, in order
+//
+// Values are not read from the slice
+func IndexesInRange[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX) iter.Seq[IDX] {
+	return func(yield func(IDX) bool) {
+		idx := firstIdx
+		ok := slice.IdxValid(idx)
+		for ok {
+			if !yield(idx) {
+				return
+			}
+			ok = idx != lastIdx
+			idx = slice.NextIdx(idx)
+			ok = ok && slice.IdxValid(idx)
+		}
+	}
+}
+
+// Append every value produced by `seq` to the end of the list
+//
+// If free space cannot be ensured for a value, iteration stops and `ok == false`
+func AppendSeq[T any, IDX Integer, L ListLike[T, IDX]](list L, seq iter.Seq[T]) (nAppended IDX, ok bool) {
+	ok = true
+	for val := range seq {
+		var slot IDX
+		slot, _, ok = TryAppendSlots(list, 1)
+		if !ok {
+			return
+		}
+		list.Set(slot, val)
+		nAppended += 1
+	}
+	return
+}
+
+// Clear the list, then append every value produced by `seq` to it
+//
+// If free space cannot be ensured for a value, iteration stops and `ok == false`
+func CollectInto[T any, IDX Integer, L ListLike[T, IDX]](list L, seq iter.Seq[T]) (nCollected IDX, ok bool) {
+	list.Clear()
+	return AppendSeq(list, seq)
+}
@@ -0,0 +1,711 @@
+package go_list_like
+
+import "slices"
+
+const (
+	linkedListNilIdx  = -1
+	linkedListFreeIdx = -2
+)
+
+type linkedListNode[T any] struct {
+	val  T
+	prev int
+	next int
+}
+
+// A doubly-linked list whose nodes are held in a single pooled golang slice.
+//
+// The index of an item is the handle of the node holding it, and remains
+// stable for as long as that item is in the list, no matter how many items
+// are inserted, deleted, or moved around it. Deleted nodes are placed on a
+// free list and reused by later insertions.
+//
+// Because handles are not in logical order, `PreferLinearOps()` is `true`
+// and `ConsecutiveIndexesInOrder()` is `false`
+//
+// The zero value is an empty list ready to use
+type LinkedList[T any] struct {
+	nodes []linkedListNode[T]
+	first int
+	last  int
+	free  int
+	nFree int
+	len   int
+}
+
+func NewLinkedList[T any](vals []T) LinkedList[T] {
+	list := EmptyLinkedList[T](len(vals))
+	if len(vals) == 0 {
+		return list
+	}
+	first, _ := list.AppendSlotsAssumeCapacity(len(vals))
+	idx := first
+	for _, v := range vals {
+		list.nodes[idx].val = v
+		idx = list.nodes[idx].next
+	}
+	return list
+}
+func EmptyLinkedList[T any](initCap int) LinkedList[T] {
+	return LinkedList[T]{
+		nodes: make([]linkedListNode[T], 0, initCap),
+		first: linkedListNilIdx,
+		last:  linkedListNilIdx,
+		free:  linkedListNilIdx,
+	}
+}
+
+func (list *LinkedList[T]) PreferLinearOps() bool {
+	return true
+}
+
+func (list *LinkedList[T]) ConsecutiveIndexesInOrder() bool {
+	return false
+}
+func (list *LinkedList[T]) AllIndexesLessThanLenValid() bool {
+	return false
+}
+
+// Returns whether the given index is valid for the slice
+func (list *LinkedList[T]) IdxValid(idx int) bool {
+	return idx >= 0 && idx < len(list.nodes) && list.nodes[idx].prev != linkedListFreeIdx
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (list *LinkedList[T]) RangeValid(firstIdx int, lastIdx int) bool {
+	if !list.IdxValid(firstIdx) || !list.IdxValid(lastIdx) {
+		return false
+	}
+	return list.isAtOrBefore(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (list *LinkedList[T]) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	middleIdx = firstIdx
+	idx := firstIdx
+	advanceMiddle := false
+	for idx != lastIdx && idx != linkedListNilIdx {
+		idx = list.nodes[idx].next
+		if advanceMiddle {
+			middleIdx = list.nodes[middleIdx].next
+		}
+		advanceMiddle = !advanceMiddle
+	}
+	return
+}
+
+// Get the value at the provided index
+func (list *LinkedList[T]) Get(idx int) (val T) {
+	return list.nodes[idx].val
+}
+
+// Set the value at the provided index to the given value
+func (list *LinkedList[T]) Set(idx int, val T) {
+	list.nodes[idx].val = val
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+//
+// The node holding the data is re-linked rather than copied, so
+// the moved item keeps its index
+func (list *LinkedList[T]) Move(oldIdx int, newIdx int) {
+	if oldIdx == newIdx {
+		return
+	}
+	if list.isAtOrBefore(oldIdx, newIdx) {
+		list.unlinkRange(oldIdx, oldIdx)
+		list.linkRangeAfter(oldIdx, oldIdx, newIdx)
+	} else {
+		list.unlinkRange(oldIdx, oldIdx)
+		list.linkRangeBefore(oldIdx, oldIdx, newIdx)
+	}
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+//
+// The nodes holding the data are re-linked rather than copied, so
+// the moved items keep their indexes
+func (list *LinkedList[T]) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	if firstIdx == newFirstIdx {
+		return
+	}
+	if list.isAtOrBefore(firstIdx, newFirstIdx) {
+		newPrevIdx := list.NthNextIdx(newFirstIdx, list.LenBetween(firstIdx, lastIdx)-1)
+		list.unlinkRange(firstIdx, lastIdx)
+		list.linkRangeAfter(firstIdx, lastIdx, newPrevIdx)
+	} else {
+		list.unlinkRange(firstIdx, lastIdx)
+		list.linkRangeBefore(firstIdx, lastIdx, newFirstIdx)
+	}
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (list *LinkedList[T]) Slice(firstIdx int, lastIdx int) (newSlice SliceLike[T, int]) {
+	return &LinkedListSlice[T]{
+		list:  list,
+		first: firstIdx,
+		last:  lastIdx,
+		len:   list.LenBetween(firstIdx, lastIdx),
+	}
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) FirstIdx() (idx int) {
+	if list.len == 0 {
+		return linkedListNilIdx
+	}
+	return list.first
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) LastIdx() (idx int) {
+	if list.len == 0 {
+		return linkedListNilIdx
+	}
+	return list.last
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) NextIdx(thisIdx int) (nextIdx int) {
+	if !list.IdxValid(thisIdx) {
+		return linkedListNilIdx
+	}
+	return list.nodes[thisIdx].next
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	if n < 0 {
+		return list.NthPrevIdx(thisIdx, -n)
+	}
+	nthNextIdx = thisIdx
+	for n > 0 && list.IdxValid(nthNextIdx) {
+		nthNextIdx = list.nodes[nthNextIdx].next
+		n -= 1
+	}
+	if n > 0 {
+		nthNextIdx = linkedListNilIdx
+	}
+	return
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) PrevIdx(thisIdx int) (prevIdx int) {
+	if !list.IdxValid(thisIdx) {
+		return linkedListNilIdx
+	}
+	return list.nodes[thisIdx].prev
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (list *LinkedList[T]) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	if n < 0 {
+		return list.NthNextIdx(thisIdx, -n)
+	}
+	nthPrevIdx = thisIdx
+	for n > 0 && list.IdxValid(nthPrevIdx) {
+		nthPrevIdx = list.nodes[nthPrevIdx].prev
+		n -= 1
+	}
+	if n > 0 {
+		nthPrevIdx = linkedListNilIdx
+	}
+	return
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (list *LinkedList[T]) Len() int {
+	return list.len
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (list *LinkedList[T]) LenBetween(firstIdx int, lastIdx int) int {
+	if !list.IdxValid(firstIdx) {
+		return 0
+	}
+	count := 1
+	idx := firstIdx
+	for idx != lastIdx {
+		idx = list.nodes[idx].next
+		if idx == linkedListNilIdx {
+			break
+		}
+		count += 1
+	}
+	return count
+}
+
+// Ensure at least `n` empty capacity spaces exist to add new items without reallocating
+// the memory or perform any other expensive reorganization procedure
+//
+// If free space cannot be ensured and attempting to add `nMoreItems`
+// will definitely fail or cause undefined behaviour, `ok == false`
+func (list *LinkedList[T]) TryEnsureFreeSlots(nMoreItems int) (ok bool) {
+	if nMoreItems > list.nFree {
+		list.nodes = slices.Grow(list.nodes, nMoreItems-list.nFree)
+	}
+	ok = true
+	return
+}
+
+// Insert `n` new slots directly before existing index, shifting all existing items
+// at and after that index forward.
+//
+// Returns the first new slot and the last new slot, inclusive. The new slots are
+// taken from the free list first, so they will usually not be consecutive indexes
+//
+// If `idx` is not a valid index, the new slots are appended to the end of the list
+func (list *LinkedList[T]) InsertSlotsAssumeCapacity(idx int, count int) (firstNewSlot int, lastNewSlot int) {
+	if !list.IdxValid(idx) {
+		return list.AppendSlotsAssumeCapacity(count)
+	}
+	if count <= 0 {
+		return linkedListNilIdx, linkedListNilIdx
+	}
+	firstNewSlot, lastNewSlot = list.allocChain(count)
+	list.linkRangeBefore(firstNewSlot, lastNewSlot, idx)
+	list.len += count
+	return
+}
+
+// Append `n` new slots at the end of the list.
+//
+// Returns the first new slot and the last new slot, inclusive.
+func (list *LinkedList[T]) AppendSlotsAssumeCapacity(count int) (firstNewSlot int, lastNewSlot int) {
+	if count <= 0 {
+		return linkedListNilIdx, linkedListNilIdx
+	}
+	firstNewSlot, lastNewSlot = list.allocChain(count)
+	if list.len == 0 {
+		list.first = firstNewSlot
+		list.last = lastNewSlot
+	} else {
+		list.linkRangeAfter(firstNewSlot, lastNewSlot, list.last)
+	}
+	list.len += count
+	return
+}
+
+// Remove all items between `firstRemoveIdx` and `lastRemovedIdx`, inclusive
+//
+// The removed nodes are returned to the free list
+func (list *LinkedList[T]) DeleteRange(firstRemovedIdx int, lastRemovedIdx int) {
+	list.unlinkRange(firstRemovedIdx, lastRemovedIdx)
+	idx := firstRemovedIdx
+	for idx != linkedListNilIdx {
+		next := list.nodes[idx].next
+		list.freeNode(idx)
+		list.len -= 1
+		idx = next
+	}
+	if list.len == 0 {
+		list.first = linkedListNilIdx
+		list.last = linkedListNilIdx
+	}
+}
+
+// Reset list to an empty state. The list's capacity is retained.
+func (list *LinkedList[T]) Clear() {
+	clear(list.nodes)
+	list.nodes = list.nodes[:0]
+	list.first = linkedListNilIdx
+	list.last = linkedListNilIdx
+	list.free = linkedListNilIdx
+	list.nFree = 0
+	list.len = 0
+}
+
+// Return the total number of values the slice/list can hold
+func (list *LinkedList[T]) Cap() int {
+	return cap(list.nodes)
+}
+
+// Get the a pointer to the value at the provided index
+//
+// The pointer remains valid until the list grows its node pool
+func (list *LinkedList[T]) GetPtr(idx int) *T {
+	return &list.nodes[idx].val
+}
+
+// Increment the start location (index/pointer/etc.) of this queue by
+// `n` positions. The new 'first' item in the queue should be the item
+// previously located at index `delta`
+func (list *LinkedList[T]) IncrementStart(n int) {
+	if n <= 0 || list.len == 0 {
+		return
+	}
+	lastRemoved := list.NthNextIdx(list.first, n-1)
+	if !list.IdxValid(lastRemoved) {
+		lastRemoved = list.last
+	}
+	list.DeleteRange(list.first, lastRemoved)
+}
+
+// Returns whether `targetIdx` can be reached by walking forward from `startIdx`
+func (list *LinkedList[T]) isAtOrBefore(startIdx int, targetIdx int) bool {
+	idx := startIdx
+	for idx != linkedListNilIdx {
+		if idx == targetIdx {
+			return true
+		}
+		idx = list.nodes[idx].next
+	}
+	return false
+}
+
+func (list *LinkedList[T]) allocNode() (idx int) {
+	if list.nFree > 0 {
+		idx = list.free
+		list.free = list.nodes[idx].next
+		list.nFree -= 1
+		list.nodes[idx] = linkedListNode[T]{prev: linkedListNilIdx, next: linkedListNilIdx}
+		return
+	}
+	idx = len(list.nodes)
+	list.nodes = append(list.nodes, linkedListNode[T]{prev: linkedListNilIdx, next: linkedListNilIdx})
+	return
+}
+
+func (list *LinkedList[T]) allocChain(count int) (first int, last int) {
+	first = list.allocNode()
+	last = first
+	for i := 1; i < count; i += 1 {
+		idx := list.allocNode()
+		list.nodes[idx].prev = last
+		list.nodes[last].next = idx
+		last = idx
+	}
+	return
+}
+
+func (list *LinkedList[T]) freeNode(idx int) {
+	list.nodes[idx] = linkedListNode[T]{prev: linkedListFreeIdx, next: list.free}
+	list.free = idx
+	list.nFree += 1
+}
+
+func (list *LinkedList[T]) unlinkRange(first int, last int) {
+	prev := list.nodes[first].prev
+	next := list.nodes[last].next
+	if prev == linkedListNilIdx {
+		list.first = next
+	} else {
+		list.nodes[prev].next = next
+	}
+	if next == linkedListNilIdx {
+		list.last = prev
+	} else {
+		list.nodes[next].prev = prev
+	}
+	list.nodes[first].prev = linkedListNilIdx
+	list.nodes[last].next = linkedListNilIdx
+}
+
+func (list *LinkedList[T]) linkRangeBefore(first int, last int, beforeIdx int) {
+	prev := list.nodes[beforeIdx].prev
+	list.nodes[first].prev = prev
+	list.nodes[last].next = beforeIdx
+	list.nodes[beforeIdx].prev = last
+	if prev == linkedListNilIdx {
+		list.first = first
+	} else {
+		list.nodes[prev].next = first
+	}
+}
+
+func (list *LinkedList[T]) linkRangeAfter(first int, last int, afterIdx int) {
+	next := list.nodes[afterIdx].next
+	list.nodes[first].prev = afterIdx
+	list.nodes[last].next = next
+	list.nodes[afterIdx].next = first
+	if next == linkedListNilIdx {
+		list.last = last
+	} else {
+		list.nodes[next].prev = last
+	}
+}
+
+var _ MemQueueLike[byte, int] = (*LinkedList[byte])(nil)
+var _ MemListLike[byte, int] = (*LinkedList[byte])(nil)
+
+// A view of a contiguous logical range of a `LinkedList[T]`, as
+// returned by `LinkedList.Slice()`
+//
+// Moves within the view shift values between the existing nodes instead of
+// re-linking them, so the boundaries of the view are never disturbed
+type LinkedListSlice[T any] struct {
+	list  *LinkedList[T]
+	first int
+	last  int
+	len   int
+}
+
+func (slice *LinkedListSlice[T]) PreferLinearOps() bool {
+	return true
+}
+
+func (slice *LinkedListSlice[T]) ConsecutiveIndexesInOrder() bool {
+	return false
+}
+func (slice *LinkedListSlice[T]) AllIndexesLessThanLenValid() bool {
+	return false
+}
+
+// Returns whether the given index is valid for the slice
+func (slice *LinkedListSlice[T]) IdxValid(idx int) bool {
+	if slice.len == 0 || !slice.list.IdxValid(idx) {
+		return false
+	}
+	i := slice.first
+	for {
+		if i == idx {
+			return true
+		}
+		if i == slice.last {
+			return false
+		}
+		i = slice.list.nodes[i].next
+	}
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (slice *LinkedListSlice[T]) RangeValid(firstIdx int, lastIdx int) bool {
+	if slice.len == 0 || !slice.list.IdxValid(firstIdx) || !slice.list.IdxValid(lastIdx) {
+		return false
+	}
+	foundFirst := false
+	i := slice.first
+	for {
+		if i == firstIdx {
+			foundFirst = true
+		}
+		if foundFirst && i == lastIdx {
+			return true
+		}
+		if i == slice.last {
+			return false
+		}
+		i = slice.list.nodes[i].next
+	}
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (slice *LinkedListSlice[T]) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	return slice.list.SplitRange(firstIdx, lastIdx)
+}
+
+// Get the value at the provided index
+func (slice *LinkedListSlice[T]) Get(idx int) (val T) {
+	return slice.list.nodes[idx].val
+}
+
+// Set the value at the provided index to the given value
+func (slice *LinkedListSlice[T]) Set(idx int, val T) {
+	slice.list.nodes[idx].val = val
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (slice *LinkedListSlice[T]) Move(oldIdx int, newIdx int) {
+	if oldIdx == newIdx {
+		return
+	}
+	nodes := slice.list.nodes
+	val := nodes[oldIdx].val
+	if slice.list.isAtOrBefore(oldIdx, newIdx) {
+		for oldIdx != newIdx {
+			next := nodes[oldIdx].next
+			nodes[oldIdx].val = nodes[next].val
+			oldIdx = next
+		}
+	} else {
+		for oldIdx != newIdx {
+			prev := nodes[oldIdx].prev
+			nodes[oldIdx].val = nodes[prev].val
+			oldIdx = prev
+		}
+	}
+	nodes[newIdx].val = val
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (slice *LinkedListSlice[T]) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	if firstIdx == newFirstIdx {
+		return
+	}
+	lenA := slice.list.LenBetween(firstIdx, lastIdx)
+	sliceA := slice.list.Slice(firstIdx, lastIdx)
+	var totalRange, sliceB SliceLike[T, int]
+	if slice.list.isAtOrBefore(firstIdx, newFirstIdx) {
+		newLastIdx := slice.list.NthNextIdx(newFirstIdx, lenA-1)
+		totalRange = slice.list.Slice(firstIdx, newLastIdx)
+		sliceB = slice.list.Slice(slice.list.nodes[lastIdx].next, newLastIdx)
+	} else {
+		totalRange = slice.list.Slice(newFirstIdx, lastIdx)
+		sliceB = slice.list.Slice(newFirstIdx, slice.list.nodes[firstIdx].prev)
+	}
+	Reverse(sliceA)
+	Reverse(sliceB)
+	Reverse(totalRange)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (slice *LinkedListSlice[T]) Slice(firstIdx int, lastIdx int) (newSlice SliceLike[T, int]) {
+	return slice.list.Slice(firstIdx, lastIdx)
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) FirstIdx() (idx int) {
+	if slice.len == 0 {
+		return linkedListNilIdx
+	}
+	return slice.first
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) LastIdx() (idx int) {
+	if slice.len == 0 {
+		return linkedListNilIdx
+	}
+	return slice.last
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) NextIdx(thisIdx int) (nextIdx int) {
+	if slice.len == 0 || thisIdx == slice.last {
+		return linkedListNilIdx
+	}
+	return slice.list.NextIdx(thisIdx)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	if n < 0 {
+		return slice.NthPrevIdx(thisIdx, -n)
+	}
+	nthNextIdx = thisIdx
+	for n > 0 && nthNextIdx != linkedListNilIdx {
+		nthNextIdx = slice.NextIdx(nthNextIdx)
+		n -= 1
+	}
+	return
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) PrevIdx(thisIdx int) (prevIdx int) {
+	if slice.len == 0 || thisIdx == slice.first {
+		return linkedListNilIdx
+	}
+	return slice.list.PrevIdx(thisIdx)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (slice *LinkedListSlice[T]) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	if n < 0 {
+		return slice.NthNextIdx(thisIdx, -n)
+	}
+	nthPrevIdx = thisIdx
+	for n > 0 && nthPrevIdx != linkedListNilIdx {
+		nthPrevIdx = slice.PrevIdx(nthPrevIdx)
+		n -= 1
+	}
+	return
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (slice *LinkedListSlice[T]) Len() int {
+	return slice.len
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (slice *LinkedListSlice[T]) LenBetween(firstIdx int, lastIdx int) int {
+	if slice.len == 0 {
+		return 0
+	}
+	return slice.list.LenBetween(firstIdx, lastIdx)
+}
+
+// Get the a pointer to the value at the provided index
+func (slice *LinkedListSlice[T]) GetPtr(idx int) *T {
+	return &slice.list.nodes[idx].val
+}
+
+// Increment the start location (index/pointer/etc.) of this queue by
+// `n` positions. The new 'first' item in the queue should be the item
+// previously located at index `delta`
+//
+// Only the view is advanced, the underlying list is not modified
+func (slice *LinkedListSlice[T]) IncrementStart(n int) {
+	if n <= 0 {
+		return
+	}
+	if n >= slice.len {
+		slice.first = linkedListNilIdx
+		slice.last = linkedListNilIdx
+		slice.len = 0
+		return
+	}
+	slice.first = slice.list.NthNextIdx(slice.first, n)
+	slice.len -= n
+}
+
+var _ MemQueueLike[byte, int] = (*LinkedListSlice[byte])(nil)
@@ -0,0 +1,136 @@
+// Package lltest provides a conformance test kit for implementations of the
+// `SliceLike`, `ListLike`, `QueueLike` and `MemSliceLike` interfaces from `go_list_like`
+//
+// The implementation is driven by a stream of operations decoded from fuzz data, and after each
+// operation its contents are compared against a plain golang slice that had the same operation
+// applied to it. The constant contracts `PreferLinearOps()`, `ConsecutiveIndexesInOrder()` and
+// `AllIndexesLessThanLenValid()` are checked against the indexes the implementation actually returns.
+// If the implementation is also a `QueueLike`, `IncrementStart()` is exercised, and if it is a
+// `MemSliceLike`, pointers returned by `GetPtr()` are checked to alias the stored values
+//
+//	func Test_MyList(t *testing.T) {
+//		lltest.TestList(t, "MyList[int]", func(b byte) int { return int(b) }, nil, newMyList, nil)
+//	}
+//
+//	func Fuzz_MyList(f *testing.F) {
+//		lltest.FuzzList(f, "MyList[int]", func(b byte) int { return int(b) }, nil, newMyList, nil)
+//	}
+package lltest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+// Set this to `true` to insert a `runtime.Breakpoint()` at the start of each
+// implementation operation test
+var EnableBreakpoints bool = false
+
+// Add the built-in seed corpus to `f`
+func InitFuzz(f *testing.F) {
+	for _, seed := range seedCorpus {
+		f.Add(seed.initialData, seed.opData)
+	}
+}
+
+// Add a single seed case to `f`, for example a failing input found while fuzzing
+func AddCustomFuzzCase(f *testing.F, initialData []byte, opData []byte) {
+	f.Add(initialData, opData)
+}
+
+// Fuzz a `SliceLike` implementation using only operations that do not change its length
+// (other than `IncrementStart()` if it is also a `QueueLike`)
+//
+// PARAMS:
+//   - ```f *testing.F```: the fuzzing object from the testing package
+//   - ```implementationTypeName string```: a string name describing the type (will print in errors)
+//   - ```gen func(b byte) T```: a function that deterministically produces a value from one byte of fuzz data
+//   - ```equal func(a T, b T) bool```: a function that reports whether two values are equal, or `nil` to use `reflect.DeepEqual()`
+//   - ```initializerFunc func(t *testing.T, initialVals []T) S```: a function that initializes the SliceLike with the provided values as an initial state
+//   - ```cleanupFunc func(t *testing.T, slice S)```: a function that performs any necessary cleanup on the SliceLike (for example if a file was created for testing), or `nil`
+func PerformSliceFuzz[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]](f *testing.F, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) S, cleanupFunc func(t *testing.T, slice S)) {
+	f.Fuzz(func(t *testing.T, initialData []byte, opData []byte) {
+		runOps(t, implementationTypeName, gen, equal, initializerFunc, cleanupFunc, _sliceOpCount, initialData, opData)
+	})
+}
+
+// Fuzz a `ListLike` implementation using all operations
+//
+// See `PerformSliceFuzz()` for a description of the parameters
+func PerformListFuzz[T any, IDX LL.Integer, L LL.ListLike[T, IDX]](f *testing.F, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) L, cleanupFunc func(t *testing.T, list L)) {
+	f.Fuzz(func(t *testing.T, initialData []byte, opData []byte) {
+		runOps(t, implementationTypeName, gen, equal, initializerFunc, cleanupFunc, _opCount, initialData, opData)
+	})
+}
+
+// Add the built-in seed corpus to `f`, then fuzz a `SliceLike` implementation with `PerformSliceFuzz()`
+//
+// When run without `-fuzz`, only the seed corpus is tested, so the results are deterministic
+func FuzzSlice[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]](f *testing.F, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) S, cleanupFunc func(t *testing.T, slice S)) {
+	InitFuzz(f)
+	PerformSliceFuzz(f, implementationTypeName, gen, equal, initializerFunc, cleanupFunc)
+}
+
+// Add the built-in seed corpus to `f`, then fuzz a `ListLike` implementation with `PerformListFuzz()`
+//
+// When run without `-fuzz`, only the seed corpus is tested, so the results are deterministic
+func FuzzList[T any, IDX LL.Integer, L LL.ListLike[T, IDX]](f *testing.F, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) L, cleanupFunc func(t *testing.T, list L)) {
+	InitFuzz(f)
+	PerformListFuzz(f, implementationTypeName, gen, equal, initializerFunc, cleanupFunc)
+}
+
+// Run the built-in seed corpus against a `SliceLike` implementation as ordinary subtests of `t`
+//
+// See `PerformSliceFuzz()` for a description of the parameters
+func TestSlice[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]](t *testing.T, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) S, cleanupFunc func(t *testing.T, slice S)) {
+	for i, seed := range seedCorpus {
+		t.Run(fmt.Sprintf("seed_%d", i), func(t *testing.T) {
+			runOps(t, implementationTypeName, gen, equal, initializerFunc, cleanupFunc, _sliceOpCount, seed.initialData, seed.opData)
+		})
+	}
+}
+
+// Run the built-in seed corpus against a `ListLike` implementation as ordinary subtests of `t`
+//
+// See `PerformSliceFuzz()` for a description of the parameters
+func TestList[T any, IDX LL.Integer, L LL.ListLike[T, IDX]](t *testing.T, implementationTypeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) L, cleanupFunc func(t *testing.T, list L)) {
+	for i, seed := range seedCorpus {
+		t.Run(fmt.Sprintf("seed_%d", i), func(t *testing.T) {
+			runOps(t, implementationTypeName, gen, equal, initializerFunc, cleanupFunc, _opCount, seed.initialData, seed.opData)
+		})
+	}
+}
+
+func runOps[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]](t *testing.T, typeName string, gen func(b byte) T, equal func(a T, b T) bool, initializerFunc func(t *testing.T, initialVals []T) S, cleanupFunc func(t *testing.T, slice S), opCount byte, initialData []byte, opData []byte) {
+	if equal == nil {
+		equal = func(a T, b T) bool {
+			return reflect.DeepEqual(a, b)
+		}
+	}
+	expect := make([]T, len(initialData))
+	for i, b := range initialData {
+		expect[i] = gen(b)
+	}
+	initialVals := make([]T, len(expect))
+	copy(initialVals, expect)
+	slice := initializerFunc(t, initialVals)
+	if cleanupFunc != nil {
+		defer cleanupFunc(t, slice)
+	}
+	r := newOpRunner(t, typeName, gen, equal, slice, expect, opData)
+	if !r.checkParity(r.slice, &r.expect, "initializerFunc") {
+		return
+	}
+	var op byte
+	var keepGoing bool = true
+	for keepGoing {
+		op, keepGoing = r.getOneOpDataVal()
+		if !keepGoing {
+			break
+		}
+		keepGoing = r.doOp(op % opCount)
+	}
+}
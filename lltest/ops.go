@@ -0,0 +1,766 @@
+package lltest
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	LL "github.com/gabe-lee/go_list_like"
+)
+
+const (
+	_MaxValuesPerOp byte = 7
+)
+
+const (
+	// SliceLike ops
+
+	_OpGet byte = iota
+	_OpSet
+	_OpMove
+	_OpMoveRange
+	_OpSlice
+	_OpFirst
+	_OpLast
+	_OpNext
+	_OpNthNext
+	_OpPrev
+	_OpNthPrev
+
+	// QueueLike ops
+
+	_OpIncrementStart
+
+	// MemSliceLike ops
+
+	_OpGetPtr
+
+	// ListLike ops
+
+	_OpInsert
+	_OpAppend
+	_OpDelete
+
+	// opCounts
+
+	_opCount
+	_sliceOpCount = _OpGetPtr + 1
+)
+
+var _OpNames = [_opCount]string{
+	_OpGet:            "Get",
+	_OpSet:            "Set",
+	_OpMove:           "Move",
+	_OpMoveRange:      "MoveRange",
+	_OpSlice:          "Slice",
+	_OpFirst:          "FirstIdx",
+	_OpLast:           "LastIdx",
+	_OpNext:           "NextIdx",
+	_OpNthNext:        "NthNextIdx",
+	_OpPrev:           "PrevIdx",
+	_OpNthPrev:        "NthPrevIdx",
+	_OpIncrementStart: "IncrementStart",
+	_OpGetPtr:         "GetPtr",
+	_OpInsert:         "InsertSlotsAssumeCapacity",
+	_OpAppend:         "AppendSlotsAssumeCapacity",
+	_OpDelete:         "DeleteRange",
+}
+
+// Applies operations decoded from fuzz data to both an implementation and an expected golang slice
+type opRunner[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]] struct {
+	t        *testing.T
+	typeName string
+	gen      func(b byte) T
+	equal    func(a T, b T) bool
+	slice    S
+	expect   []T
+	opData   []byte
+	list     LL.ListLike[T, IDX]
+	isList   bool
+	queue    LL.QueueLike[T, IDX]
+	isQueue  bool
+	mem      LL.MemSliceLike[T, IDX]
+	isMem    bool
+	// The contract values reported before any operations, which must never change
+	preferLinearOps            bool
+	consecutiveIndexesInOrder  bool
+	allIndexesLessThanLenValid bool
+}
+
+func newOpRunner[T any, IDX LL.Integer, S LL.SliceLike[T, IDX]](t *testing.T, typeName string, gen func(b byte) T, equal func(a T, b T) bool, slice S, expect []T, opData []byte) *opRunner[T, IDX, S] {
+	r := &opRunner[T, IDX, S]{
+		t:                          t,
+		typeName:                   typeName,
+		gen:                        gen,
+		equal:                      equal,
+		slice:                      slice,
+		expect:                     expect,
+		opData:                     opData,
+		preferLinearOps:            slice.PreferLinearOps(),
+		consecutiveIndexesInOrder:  slice.ConsecutiveIndexesInOrder(),
+		allIndexesLessThanLenValid: slice.AllIndexesLessThanLenValid(),
+	}
+	r.list, r.isList = any(slice).(LL.ListLike[T, IDX])
+	r.queue, r.isQueue = any(slice).(LL.QueueLike[T, IDX])
+	r.mem, r.isMem = any(slice).(LL.MemSliceLike[T, IDX])
+	return r
+}
+
+func (r *opRunner[T, IDX, S]) logImplError(slice LL.SliceLike[T, IDX], expSlice *[]T, reason string, opName string, opArgs ...any) {
+	r.t.Helper()
+	gotSlice := make([]T, 0, slice.Len())
+	LL.DoActionOnAllItems(slice, func(slice LL.SliceLike[T, IDX], idx IDX, item T) {
+		gotSlice = append(gotSlice, item)
+	})
+	if len(opArgs) > 0 {
+		r.t.Errorf("go_list_like: implementation test: operation `%s.%s(%s)` failed test:\n\tREASON: %s\n\tEXP: %v\n\tGOT: %v\n", r.typeName, opName, stringifyParamList(opArgs...), reason, *expSlice, gotSlice)
+	} else {
+		r.t.Errorf("go_list_like: implementation test: operation `%s.%s()` failed test:\n\tREASON: %s\n\tEXP: %v\n\tGOT: %v\n", r.typeName, opName, reason, *expSlice, gotSlice)
+	}
+}
+
+// Check that the constant contract values have not changed since the implementation was initialized
+func (r *opRunner[T, IDX, S]) checkContracts(opName string, opArgs ...any) (ok bool) {
+	ok = true
+	if r.slice.PreferLinearOps() != r.preferLinearOps {
+		ok = false
+		r.logImplError(r.slice, &r.expect, fmt.Sprintf("PreferLinearOps() changed from %t to %t, but must be constant", r.preferLinearOps, !r.preferLinearOps), opName, opArgs...)
+	}
+	if r.slice.ConsecutiveIndexesInOrder() != r.consecutiveIndexesInOrder {
+		ok = false
+		r.logImplError(r.slice, &r.expect, fmt.Sprintf("ConsecutiveIndexesInOrder() changed from %t to %t, but must be constant", r.consecutiveIndexesInOrder, !r.consecutiveIndexesInOrder), opName, opArgs...)
+	}
+	if r.slice.AllIndexesLessThanLenValid() != r.allIndexesLessThanLenValid {
+		ok = false
+		r.logImplError(r.slice, &r.expect, fmt.Sprintf("AllIndexesLessThanLenValid() changed from %t to %t, but must be constant", r.allIndexesLessThanLenValid, !r.allIndexesLessThanLenValid), opName, opArgs...)
+	}
+	return
+}
+
+// Check that `slice` holds exactly the values in `goSlice` in order, and that the indexes
+// it returns honor its `ConsecutiveIndexesInOrder()` and `AllIndexesLessThanLenValid()` contracts
+func (r *opRunner[T, IDX, S]) checkParity(slice LL.SliceLike[T, IDX], goSlice *[]T, opName string, opArgs ...any) (ok bool) {
+	ok = r.checkContracts(opName, opArgs...)
+	if !ok {
+		return
+	}
+	if IDX(len(*goSlice)) != slice.Len() {
+		ok = false
+		r.logImplError(slice, goSlice, fmt.Sprintf("expected length (%d) does not equal reported length (%d)", len(*goSlice), slice.Len()), opName, opArgs...)
+		return
+	}
+	if slice.LenBetween(slice.FirstIdx(), slice.LastIdx()) != slice.Len() {
+		ok = false
+		r.logImplError(slice, goSlice, fmt.Sprintf("reported length (%d) does not equal reported length-between first and last indexes (%d)", slice.Len(), slice.LenBetween(slice.FirstIdx(), slice.LastIdx())), opName, opArgs...)
+		return
+	}
+	if slice.AllIndexesLessThanLenValid() {
+		for i := IDX(0); i < slice.Len(); i += 1 {
+			if !slice.IdxValid(i) {
+				ok = false
+				r.logImplError(slice, goSlice, fmt.Sprintf("AllIndexesLessThanLenValid() == true, but index %d is invalid (len == %d)", i, slice.Len()), opName, opArgs...)
+				return
+			}
+		}
+	}
+	consecutive := slice.ConsecutiveIndexesInOrder()
+	var prevIdx IDX
+	llIdx := slice.FirstIdx()
+	llOk := slice.IdxValid(llIdx)
+	goIdx := 0
+	goOk := goIdx < len(*goSlice)
+	var goVal, llVal T
+	for {
+		if !llOk && !goOk {
+			break
+		}
+		if !llOk && goOk {
+			ok = false
+			r.logImplError(slice, goSlice, "ListLike implementation returned an invalid idx where a valid index was expected", opName, opArgs...)
+			break
+		}
+		if llOk && !goOk {
+			ok = false
+			r.logImplError(slice, goSlice, "ListLike implementation returned a valid idx where an invalid index was expected", opName, opArgs...)
+			break
+		}
+		if consecutive && goIdx > 0 && llIdx != prevIdx+1 {
+			ok = false
+			r.logImplError(slice, goSlice, fmt.Sprintf("ConsecutiveIndexesInOrder() == true, but the index after %d was %d", prevIdx, llIdx), opName, opArgs...)
+			break
+		}
+		goVal = (*goSlice)[goIdx]
+		llVal = slice.Get(llIdx)
+		if !r.equal(goVal, llVal) {
+			ok = false
+			r.logImplError(slice, goSlice, fmt.Sprintf("value at index `%d` places from beginning (idx %d) did not match the expected value, EXP %v, GOT %v", goIdx, llIdx, goVal, llVal), opName, opArgs...)
+			break
+		}
+		goIdx += 1
+		goOk = goIdx < len(*goSlice)
+		prevIdx = llIdx
+		llIdx = slice.NextIdx(llIdx)
+		llOk = slice.IdxValid(llIdx)
+	}
+	return
+}
+
+func (r *opRunner[T, IDX, S]) getOneOpIndex() (index int, hasData bool) {
+	if len(r.opData) <= 0 {
+		return
+	}
+	hasData = true
+	index = int(r.opData[0])
+	if len(r.opData) > 1 {
+		index |= int(r.opData[1]) << 8
+		r.opData = r.opData[2:]
+	} else {
+		r.opData = r.opData[1:]
+	}
+	return
+}
+func (r *opRunner[T, IDX, S]) getOneOpDataVal() (val byte, hasData bool) {
+	if len(r.opData) <= 0 {
+		return
+	}
+	hasData = true
+	val = r.opData[0]
+	r.opData = r.opData[1:]
+	return
+}
+func (r *opRunner[T, IDX, S]) getManyOpDataVals() (vals []T, hasData bool) {
+	if len(r.opData) < 2 {
+		return
+	}
+	n, _ := r.getOneOpDataVal()
+	n = max(min(n, _MaxValuesPerOp, byte(len(r.opData))), 1)
+	vals = make([]T, n)
+	for i, b := range r.opData[:n] {
+		vals[i] = r.gen(b)
+	}
+	r.opData = r.opData[n:]
+	hasData = len(vals) > 0
+	return
+}
+
+func (r *opRunner[T, IDX, S]) checkIdxParity(nthIdx int, op byte, llIdx IDX, llValid bool) (valid bool) {
+	var goVal, llVal T
+	if nthIdx >= len(r.expect) || nthIdx < 0 {
+		if llValid {
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("index that is %d positions from beginning (%d) should have been an invalid index, but wasn't (len == %d)", nthIdx, llIdx, len(r.expect)), _OpNames[op], llIdx)
+		}
+		valid = false
+	} else {
+		if !llValid {
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("index that is %d positions from beginning (%d) should have been a valid index, but wasn't (len == %d)", nthIdx, llIdx, len(r.expect)), _OpNames[op], llIdx)
+		} else {
+			goVal = r.expect[nthIdx]
+			llVal = r.slice.Get(llIdx)
+			valid = r.equal(goVal, llVal)
+			if !valid {
+				r.logImplError(r.slice, &r.expect, fmt.Sprintf("index that is %d positions from beginning (%d) did not have the same value as the one %d positions from the start of the expected slice, EXP %v, GOT %v", nthIdx, llIdx, nthIdx, goVal, llVal), _OpNames[op], llIdx)
+			}
+		}
+	}
+	return
+}
+
+func (r *opRunner[T, IDX, S]) checkRangeAndSwapIfNeeded(nthA int, nthB int, llNthA, llNthB IDX, op byte) (nthAA, nthBB int, llNthAA, llNthBB IDX, valid bool) {
+	valid = true
+	if nthA > nthB {
+		wasInvalid := !r.slice.RangeValid(llNthA, llNthB)
+		if !wasInvalid {
+			valid = false
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("range (nth-position) [%d, %d] (true index [%d, %d]) should have reported as an invalid range but reported valid", nthA, nthB, llNthA, llNthB), _OpNames[op], llNthA, llNthB)
+		}
+		nthAA = nthB
+		nthBB = nthA
+		llNthAA = llNthB
+		llNthBB = llNthA
+	} else {
+		nthAA = nthA
+		nthBB = nthB
+		llNthAA = llNthA
+		llNthBB = llNthB
+	}
+	if nthA < 0 || int(nthB) >= len(r.expect) {
+		wasInvalid := !r.slice.RangeValid(llNthA, llNthB)
+		if !wasInvalid {
+			valid = false
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("range (nth-position) [%d, %d] (true index [%d, %d]) should have reported as an invalid range but reported valid", nthA, nthB, llNthA, llNthB), _OpNames[op], llNthA, llNthB)
+		}
+	} else {
+		wasValid := r.slice.RangeValid(llNthAA, llNthBB)
+		if !wasValid {
+			valid = false
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("range (nth-position) [%d, %d] (true index [%d, %d]) should have reported as a valid range but reported invalid", nthA, nthB, llNthA, llNthB), _OpNames[op], llNthA, llNthB)
+		}
+	}
+	return
+}
+
+func (r *opRunner[T, IDX, S]) checkAndGetNthIdx(nthIdx int, op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryNthIdx(r.slice, IDX(nthIdx))
+	valid = valid && r.checkIdxParity(nthIdx, op, idx, valid)
+	return
+}
+
+func (r *opRunner[T, IDX, S]) checkAndGetFirstIdx(op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryFirstIdx(r.slice)
+	valid = valid && r.checkIdxParity(0, op, idx, valid)
+	return
+}
+func (r *opRunner[T, IDX, S]) checkAndGetLastIdx(op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryLastIdx(r.slice)
+	valid = valid && r.checkIdxParity(len(r.expect)-1, op, idx, valid)
+	return
+}
+
+func (r *opRunner[T, IDX, S]) checkAndGetPrevIdx(startIdx int, op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryNthIdx(r.slice, IDX(startIdx))
+	valid = valid && r.checkIdxParity(startIdx, op, idx, valid)
+	if !valid {
+		return
+	}
+	idx, valid = LL.TryPrevIdx(r.slice, idx)
+	valid = valid && r.checkIdxParity(startIdx-1, op, idx, valid)
+	return
+}
+func (r *opRunner[T, IDX, S]) checkAndGetNextIdx(startIdx int, op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryNthIdx(r.slice, IDX(startIdx))
+	valid = valid && r.checkIdxParity(startIdx, op, idx, valid)
+	if !valid {
+		return
+	}
+	idx, valid = LL.TryNextIdx(r.slice, idx)
+	valid = valid && r.checkIdxParity(startIdx+1, op, idx, valid)
+	return
+}
+func (r *opRunner[T, IDX, S]) checkAndGetNthPrevIdx(startIdx int, n int, op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryNthIdx(r.slice, IDX(startIdx))
+	valid = valid && r.checkIdxParity(startIdx, op, idx, valid)
+	if !valid {
+		return
+	}
+	idx, valid = LL.TryNthPrevIdx(r.slice, idx, IDX(n))
+	valid = valid && r.checkIdxParity(startIdx-n, op, idx, valid)
+	return
+}
+func (r *opRunner[T, IDX, S]) checkAndGetNthNextIdx(startIdx int, n int, op byte) (idx IDX, valid bool) {
+	idx, valid = LL.TryNthIdx(r.slice, IDX(startIdx))
+	valid = valid && r.checkIdxParity(startIdx, op, idx, valid)
+	if !valid {
+		return
+	}
+	idx, valid = LL.TryNthNextIdx(r.slice, idx, IDX(n))
+	valid = valid && r.checkIdxParity(startIdx+n, op, idx, valid)
+	return
+}
+
+func (r *opRunner[T, IDX, S]) doOp(op byte) (couldDo bool) {
+	var nthIdxA, nthIdxB, nthIdxC, nthIdxD int
+	var llNthIdxA, llNthIdxB, llNthIdxC, llNthIdxD IDX
+	var opVal byte
+	var setVal T
+	var insertVals []T
+	if EnableBreakpoints {
+		runtime.Breakpoint()
+	}
+	switch op {
+	case _OpGet:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		_, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+	case _OpSet:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		opVal, couldDo = r.getOneOpDataVal()
+		if !couldDo {
+			return
+		}
+		setVal = r.gen(opVal)
+		r.slice.Set(llNthIdxA, setVal)
+		r.expect[nthIdxA] = setVal
+		couldDo = r.checkIdxParity(nthIdxA, op, llNthIdxA, true)
+		if !couldDo {
+			return
+		}
+	case _OpMove:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		nthIdxB, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		llNthIdxB, couldDo = r.checkAndGetNthIdx(nthIdxB, op)
+		if !couldDo {
+			return
+		}
+		r.slice.Move(llNthIdxA, llNthIdxB)
+		sliceMoveOne(r.expect, nthIdxA, nthIdxB)
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], llNthIdxA, llNthIdxB)
+		if !couldDo {
+			return
+		}
+	case _OpMoveRange:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		nthIdxB, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		nthIdxA, nthIdxB, couldDo = clampRange(len(r.expect), nthIdxA, nthIdxB)
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		llNthIdxB, couldDo = r.checkAndGetNthIdx(nthIdxB, op)
+		if !couldDo {
+			return
+		}
+		nthIdxC, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxC, couldDo = r.checkAndGetNthIdx(nthIdxC, op)
+		if !couldDo {
+			return
+		}
+		nthIdxD = nthIdxC + (nthIdxB - nthIdxA)
+		llNthIdxD, couldDo = r.checkAndGetNthIdx(nthIdxD, op)
+		couldDo = couldDo && r.slice.IdxValid(llNthIdxD)
+		if !couldDo {
+			return
+		}
+		nthIdxA, nthIdxB, llNthIdxA, llNthIdxB, couldDo = r.checkRangeAndSwapIfNeeded(nthIdxA, nthIdxB, llNthIdxA, llNthIdxB, op)
+		if !couldDo {
+			return
+		}
+		r.slice.MoveRange(llNthIdxA, llNthIdxB, llNthIdxC)
+		sliceMoveRange(r.expect, nthIdxA, nthIdxB, nthIdxC)
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], llNthIdxA, llNthIdxB, llNthIdxC)
+		if !couldDo {
+			return
+		}
+	case _OpSlice:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		nthIdxB, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxB, couldDo = r.checkAndGetNthIdx(nthIdxB, op)
+		if !couldDo {
+			return
+		}
+		nthIdxA, nthIdxB, llNthIdxA, llNthIdxB, couldDo = r.checkRangeAndSwapIfNeeded(nthIdxA, nthIdxB, llNthIdxA, llNthIdxB, op)
+		if !couldDo {
+			return
+		}
+		subSlice := r.slice.Slice(llNthIdxA, llNthIdxB)
+		subExpect := r.expect[nthIdxA : nthIdxB+1]
+		couldDo = r.checkParity(subSlice, &subExpect, _OpNames[op], llNthIdxA, llNthIdxB)
+		if !couldDo {
+			return
+		}
+	case _OpFirst:
+		_, couldDo = r.checkAndGetFirstIdx(op)
+		if !couldDo {
+			return
+		}
+	case _OpLast:
+		_, couldDo = r.checkAndGetLastIdx(op)
+		if !couldDo {
+			return
+		}
+	case _OpNext:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		_, couldDo = r.checkAndGetNextIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+	case _OpNthNext:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		nthIdxB, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		_, couldDo = r.checkAndGetNthNextIdx(nthIdxA, nthIdxB, op)
+		if !couldDo {
+			return
+		}
+	case _OpPrev:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		_, couldDo = r.checkAndGetPrevIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+	case _OpNthPrev:
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		nthIdxB, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		_, couldDo = r.checkAndGetNthPrevIdx(nthIdxA, nthIdxB, op)
+		if !couldDo {
+			return
+		}
+	case _OpIncrementStart:
+		if !r.isQueue {
+			couldDo = true
+			return
+		}
+		opVal, couldDo = r.getOneOpDataVal()
+		if !couldDo {
+			return
+		}
+		nthIdxA = min(int(opVal%(_MaxValuesPerOp+1)), len(r.expect))
+		r.queue.IncrementStart(IDX(nthIdxA))
+		r.expect = r.expect[nthIdxA:]
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], nthIdxA)
+		if !couldDo {
+			return
+		}
+	case _OpGetPtr:
+		if !r.isMem {
+			couldDo = true
+			return
+		}
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		ptr := r.mem.GetPtr(llNthIdxA)
+		if !r.equal(*ptr, r.expect[nthIdxA]) {
+			couldDo = false
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("pointer did not point to the value at index %d, EXP %v, GOT %v", llNthIdxA, r.expect[nthIdxA], *ptr), _OpNames[op], llNthIdxA)
+			return
+		}
+		opVal, couldDo = r.getOneOpDataVal()
+		if !couldDo {
+			return
+		}
+		setVal = r.gen(opVal)
+		*ptr = setVal
+		r.expect[nthIdxA] = setVal
+		couldDo = r.checkIdxParity(nthIdxA, op, llNthIdxA, true)
+		if !couldDo {
+			return
+		}
+		opVal, couldDo = r.getOneOpDataVal()
+		if !couldDo {
+			return
+		}
+		setVal = r.gen(opVal)
+		r.slice.Set(llNthIdxA, setVal)
+		r.expect[nthIdxA] = setVal
+		if !r.equal(*ptr, setVal) {
+			couldDo = false
+			r.logImplError(r.slice, &r.expect, fmt.Sprintf("pointer did not observe `Set()` at index %d, EXP %v, GOT %v", llNthIdxA, setVal, *ptr), _OpNames[op], llNthIdxA)
+			return
+		}
+	case _OpInsert:
+		if !r.isList {
+			couldDo = true
+			return
+		}
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		insertVals, couldDo = r.getManyOpDataVals()
+		if !couldDo {
+			return
+		}
+		llInsertVals := LL.NewSliceAdapter(insertVals)
+		gotFree := r.list.TryEnsureFreeSlots(IDX(len(insertVals)))
+		if !gotFree {
+			couldDo = true
+			return
+		}
+		llNthIdxB, llNthIdxC = r.list.InsertSlotsAssumeCapacity(llNthIdxA, IDX(len(insertVals)))
+		LL.CopyToRange(&llInsertVals, r.list, llNthIdxB, llNthIdxC)
+		r.expect = slices.Insert(r.expect, nthIdxA, insertVals...)
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], llNthIdxA, IDX(len(insertVals)), insertVals)
+		if !couldDo {
+			return
+		}
+	case _OpAppend:
+		if !r.isList {
+			couldDo = true
+			return
+		}
+		insertVals, couldDo = r.getManyOpDataVals()
+		if !couldDo {
+			return
+		}
+		llInsertVals := LL.NewSliceAdapter(insertVals)
+		gotFree := r.list.TryEnsureFreeSlots(IDX(len(insertVals)))
+		if !gotFree {
+			couldDo = true
+			return
+		}
+		llNthIdxB, llNthIdxC = r.list.AppendSlotsAssumeCapacity(IDX(len(insertVals)))
+		LL.CopyToRange(&llInsertVals, r.list, llNthIdxB, llNthIdxC)
+		r.expect = append(r.expect, insertVals...)
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], IDX(len(insertVals)), insertVals)
+		if !couldDo {
+			return
+		}
+	case _OpDelete:
+		if !r.isList || len(r.expect) == 0 {
+			couldDo = true
+			return
+		}
+		nthIdxA, couldDo = r.getOneOpIndex()
+		if !couldDo {
+			return
+		}
+		llNthIdxA, couldDo = r.checkAndGetNthIdx(nthIdxA, op)
+		if !couldDo {
+			return
+		}
+		opVal, couldDo = r.getOneOpDataVal()
+		if !couldDo {
+			return
+		}
+		opVal = max(opVal%_MaxValuesPerOp, 1)
+		nthIdxB = nthIdxA + int(opVal)
+		nthIdxB = min(nthIdxB, len(r.expect)-1)
+		if nthIdxA == nthIdxB {
+			couldDo = true
+			return
+		}
+		llNthIdxB, couldDo = r.checkAndGetNthIdx(nthIdxB, op)
+		if !couldDo {
+			return
+		}
+		r.list.DeleteRange(llNthIdxA, llNthIdxB)
+		r.expect = slices.Delete(r.expect, nthIdxA, nthIdxB+1)
+		couldDo = r.checkParity(r.slice, &r.expect, _OpNames[op], llNthIdxA, llNthIdxB)
+		if !couldDo {
+			return
+		}
+	default:
+		panic(fmt.Sprintf("somehow, an invalid op-code was found (%d, max valid == %d)", op, _opCount-1))
+	}
+	couldDo = true
+	return
+}
+
+func sliceMoveOne[T any](slice []T, old, new int) {
+	val := slice[old]
+	if new < old {
+		i := old
+		ii := old - 1
+		for i > new {
+			slice[i] = slice[ii]
+			i = ii
+			ii -= 1
+		}
+	} else {
+		i := old
+		ii := old + 1
+		for i < new {
+			slice[i] = slice[ii]
+			i = ii
+			ii += 1
+		}
+	}
+	slice[new] = val
+}
+
+func sliceMoveRange[T any](slice []T, firstOld, lastOld, firstNew int) {
+	lenA := (lastOld - firstOld) + 1
+	sliceA := slice[firstOld : firstOld+lenA]
+	var totalRange, sliceB []T
+	if firstNew < firstOld {
+		totalRange = slice[firstNew : lastOld+1]
+		sliceB = slice[firstNew:firstOld]
+	} else {
+		totalRange = slice[firstOld : firstNew+lenA]
+		sliceB = slice[lastOld+1 : firstNew+lenA]
+	}
+	slices.Reverse(sliceA)
+	slices.Reverse(sliceB)
+	slices.Reverse(totalRange)
+}
+
+func stringifyParamList(args ...any) string {
+	builder := strings.Builder{}
+	for _, a := range args {
+		builder.WriteString(fmt.Sprintf("%v, ", a))
+	}
+	result := builder.String()
+	return result[:len(result)-2]
+}
+
+func clampRange(sliceLen int, first, last int) (ff, ll int, ok bool) {
+	ok = true
+	ff = first
+	ll = last
+	if ff > ll {
+		ff = last
+		ll = first
+	}
+	cc := ll - ff
+	cc = min(cc, int(_MaxValuesPerOp))
+	ll = ff + cc
+	if ll >= sliceLen {
+		ll = sliceLen - 1
+	}
+	if ff > ll || ll >= sliceLen {
+		ok = false
+	}
+	return
+}
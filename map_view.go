@@ -0,0 +1,235 @@
+package go_list_like
+
+// Presents a `SliceLike[U, IDX]` as a `SliceLike[T, IDX]`, using `get` to project
+// each underlying value to a value of type `T`, and `set` to return a copy of an
+// underlying value with its projected value replaced
+//
+//	names := NewMapView(&people, func(p Person) string { return p.Name }, func(p Person, name string) Person {
+//		p.Name = name
+//		return p
+//	})
+//
+// The view uses the same indexes as the underlying slice. `Move()` and `MoveRange()` move
+// whole underlying values, not just their projected values, so sorting the view with a
+// `Move()` based algorithm reorders the underlying values, while sorting it with a `Set()`
+// based algorithm only reorders the projected values
+//
+// If `set` is `nil`, the view is read-only and `Set()` panics
+type MapView[T any, U any, IDX Integer] struct {
+	slice SliceLike[U, IDX]
+	get   func(item U) (val T)
+	set   func(item U, val T) (newItem U)
+}
+
+func NewMapView[T any, U any, IDX Integer, S SliceLike[U, IDX]](slice S, get func(item U) (val T), set func(item U, val T) (newItem U)) MapView[T, U, IDX] {
+	return MapView[T, U, IDX]{
+		slice: slice,
+		get:   get,
+		set:   set,
+	}
+}
+
+// Return the underlying `SliceLike[U, IDX]`
+func (view MapView[T, U, IDX]) Inner() SliceLike[U, IDX] {
+	return view.slice
+}
+
+// SliceLike
+
+func (view MapView[T, U, IDX]) PreferLinearOps() bool {
+	return view.slice.PreferLinearOps()
+}
+
+func (view MapView[T, U, IDX]) ConsecutiveIndexesInOrder() bool {
+	return view.slice.ConsecutiveIndexesInOrder()
+}
+func (view MapView[T, U, IDX]) AllIndexesLessThanLenValid() bool {
+	return view.slice.AllIndexesLessThanLenValid()
+}
+
+// Returns whether the given index is valid for the slice
+func (view MapView[T, U, IDX]) IdxValid(idx IDX) bool {
+	return view.slice.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view MapView[T, U, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	return view.slice.RangeValid(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view MapView[T, U, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	return view.slice.SplitRange(firstIdx, lastIdx)
+}
+
+// Get the value at the provided index
+func (view MapView[T, U, IDX]) Get(idx IDX) (val T) {
+	return view.get(view.slice.Get(idx))
+}
+
+// Set the value at the provided index to the given value
+func (view MapView[T, U, IDX]) Set(idx IDX, val T) {
+	view.slice.Set(idx, view.set(view.slice.Get(idx), val))
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view MapView[T, U, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	view.slice.Move(oldIdx, newIdx)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view MapView[T, U, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	view.slice.MoveRange(firstIdx, lastIdx, newFirstIdx)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view MapView[T, U, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return NewMapView(view.slice.Slice(firstIdx, lastIdx), view.get, view.set)
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) FirstIdx() (idx IDX) {
+	return view.slice.FirstIdx()
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) LastIdx() (idx IDX) {
+	return view.slice.LastIdx()
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	return view.slice.NextIdx(thisIdx)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	return view.slice.NthNextIdx(thisIdx, n)
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	return view.slice.PrevIdx(thisIdx)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view MapView[T, U, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	return view.slice.NthPrevIdx(thisIdx, n)
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view MapView[T, U, IDX]) Len() IDX {
+	return view.slice.Len()
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view MapView[T, U, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	return view.slice.LenBetween(firstIdx, lastIdx)
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view MapView[T, U, IDX]) Err() error {
+	return CheckErr(view.slice)
+}
+
+// Clear any error recorded by the underlying slice
+func (view MapView[T, U, IDX]) ResetErr() {
+	ResetErr(view.slice)
+}
+
+// Presents a `ListLike[U, IDX]` as a `ListLike[T, IDX]`, in the same way as `MapView`
+//
+// New slots hold whatever underlying value the list creates for them (usually the zero value),
+// and setting a projected value in a new slot calls `set` on that underlying value
+type MapListView[T any, U any, IDX Integer] struct {
+	MapView[T, U, IDX]
+	list ListLike[U, IDX]
+}
+
+func NewMapListView[T any, U any, IDX Integer, L ListLike[U, IDX]](list L, get func(item U) (val T), set func(item U, val T) (newItem U)) MapListView[T, U, IDX] {
+	return MapListView[T, U, IDX]{
+		MapView: NewMapView[T, U, IDX](list, get, set),
+		list:    list,
+	}
+}
+
+// ListLike
+
+// Ensure at least `n` empty capacity spaces exist to add new items without reallocating
+// the memory or perform any other expensive reorganization procedure
+//
+// If free space cannot be ensured and attempting to add `nMoreItems`
+// will definitely fail or cause undefined behaviour, `ok == false`
+func (view MapListView[T, U, IDX]) TryEnsureFreeSlots(nMoreItems IDX) (ok bool) {
+	return view.list.TryEnsureFreeSlots(nMoreItems)
+}
+
+// Insert `n` new slots directly before existing index, shifting all existing items
+// at and after that index forward. Returns the first new slot and the last new slot, inclusive.
+func (view MapListView[T, U, IDX]) InsertSlotsAssumeCapacity(idx IDX, count IDX) (firstNewSlot IDX, lastNewSlot IDX) {
+	return view.list.InsertSlotsAssumeCapacity(idx, count)
+}
+
+// Append `n` new slots at the end of the list.
+//
+// Returns the first new slot and the last new slot, inclusive.
+func (view MapListView[T, U, IDX]) AppendSlotsAssumeCapacity(count IDX) (firstNewSlot IDX, lastNewSlot IDX) {
+	return view.list.AppendSlotsAssumeCapacity(count)
+}
+
+// Remove all items between `firstRemoveIdx` and `lastRemovedIdx`, inclusive
+//
+// All items after `lastRemovedIdx` are shifted backward
+func (view MapListView[T, U, IDX]) DeleteRange(firstRemovedIdx IDX, lastRemovedIdx IDX) {
+	view.list.DeleteRange(firstRemovedIdx, lastRemovedIdx)
+}
+
+// Reset list to an empty state. The list's capacity may or may not be retained.
+func (view MapListView[T, U, IDX]) Clear() {
+	view.list.Clear()
+}
+
+// Return the total number of values the slice/list can hold
+func (view MapListView[T, U, IDX]) Cap() IDX {
+	return view.list.Cap()
+}
+
+func (view MapView[T, U, IDX]) storage() (token any, known bool) {
+	return storageOf(view.slice)
+}
+
+var _ SliceLike[byte, int] = MapView[byte, uint16, int]{}
+var _ ErrorReporter = MapView[byte, uint16, int]{}
+var _ ListLike[byte, int] = MapListView[byte, uint16, int]{}
@@ -19,6 +19,20 @@ func TryGetPtr[T any, IDX Integer, S MemSliceLike[T, IDX]](memSliceLike S, idx I
 	return
 }
 
+// Implemented by `MemSliceLike` types that can promise a range of values is stored contiguously,
+// such as a ring buffer whose range does not wrap around the end of its memory
+//
+// The helper functions only handle a range of a `MemSliceLike` as a golang slice when it implements
+// this interface (or `GoSliceLike`), and never guess from the pointers `GetPtr()` returns
+type ContiguousMemSliceLike[T any, IDX Integer] interface {
+	MemSliceLike[T, IDX]
+	// Return the `n` values starting at `firstIdx` as a golang slice that shares memory with the slice,
+	// or `ok == false` if they are not stored one after another in memory
+	//
+	// Assumes `n` consecutive indexes starting at `firstIdx` are valid
+	ContiguousSpan(firstIdx IDX, n IDX) (span []T, ok bool)
+}
+
 type MemListLike[T any, IDX Integer] interface {
 	MemSliceLike[T, IDX]
 	ListLike[T, IDX]
@@ -0,0 +1,72 @@
+package go_list_like
+
+import (
+	"slices"
+	"testing"
+)
+
+func Fuzz_Partition_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, byte(0), byte(0), byte(0))
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7}, byte(4), byte(1), byte(2))
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, byte(40), byte(7), byte(0))
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5}, byte(5), byte(3), byte(20))
+	f.Fuzz(func(t *testing.T, a []byte, pivot byte, rotFirst byte, rotLen byte) {
+		inFirstGroup := func(x byte) bool {
+			return x < pivot
+		}
+		newLists := newByteLists(pivot)
+		var expFirst, expSecond []byte
+		for _, x := range a {
+			if inFirstGroup(x) {
+				expFirst = append(expFirst, x)
+			} else {
+				expSecond = append(expSecond, x)
+			}
+		}
+		expStable := append(slices.Clone(expFirst), expSecond...)
+		for name, newList := range newLists {
+			if len(a) > 0 {
+				first := int(rotFirst) % len(a)
+				last := first + int(rotLen)%(len(a)-first)
+				middle := first + int(rotFirst/2)%(last-first+1)
+				exp := slices.Clone(a)
+				slices.Reverse(exp[first:middle])
+				slices.Reverse(exp[middle : last+1])
+				slices.Reverse(exp[first : last+1])
+				list := newList(slices.Clone(a))
+				newIdx := Rotate(list, NthIdx(list, first), NthIdx(list, middle), NthIdx(list, last))
+				if got := collectBytes(list); !slices.Equal(exp, got) || list.Get(newIdx) != a[first] {
+					t.Errorf("\nFAIL: %s Rotate(%d, %d, %d)\nEXP: %v\nGOT: %v, value at returned index %d", name, first, middle, last, exp, got, list.Get(newIdx))
+				}
+			}
+			list := newList(slices.Clone(a))
+			secondIdx := Partition(list, inFirstGroup)
+			got := collectBytes(list)
+			sortedFirst, sortedSecond := slices.Clone(got[:len(expFirst)]), slices.Clone(got[len(expFirst):])
+			slices.Sort(sortedFirst)
+			slices.Sort(sortedSecond)
+			expSortedFirst, expSortedSecond := slices.Clone(expFirst), slices.Clone(expSecond)
+			slices.Sort(expSortedFirst)
+			slices.Sort(expSortedSecond)
+			if !slices.Equal(sortedFirst, expSortedFirst) || !slices.Equal(sortedSecond, expSortedSecond) || secondIdx != list.NthNextIdx(list.FirstIdx(), len(expFirst)) {
+				t.Errorf("\nFAIL: %s Partition(< %d)\nEXP: %v | %v\nGOT: %v", name, pivot, expFirst, expSecond, got)
+			}
+			list = newList(slices.Clone(a))
+			secondIdx = StablePartition(list, inFirstGroup)
+			if got := collectBytes(list); !slices.Equal(got, expStable) || secondIdx != list.NthNextIdx(list.FirstIdx(), len(expFirst)) {
+				t.Errorf("\nFAIL: %s StablePartition(< %d)\nEXP: %v\nGOT: %v", name, pivot, expStable, got)
+			}
+			list = newList(slices.Clone(a))
+			nRemoved := RemoveIf(list, inFirstGroup)
+			if got := collectBytes(list); !slices.Equal(got, expSecond) || nRemoved != len(expFirst) || list.Len() != len(expSecond) {
+				t.Errorf("\nFAIL: %s RemoveIf(< %d)\nEXP: %v\nGOT: %v (%d removed)", name, pivot, expSecond, got, nRemoved)
+			}
+			list = newList(slices.Clone(a))
+			nRemoved = RetainIf(list, inFirstGroup)
+			if got := collectBytes(list); !slices.Equal(got, expFirst) || nRemoved != len(expSecond) || list.Len() != len(expFirst) {
+				t.Errorf("\nFAIL: %s RetainIf(< %d)\nEXP: %v\nGOT: %v (%d removed)", name, pivot, expFirst, got, nRemoved)
+			}
+		}
+	})
+}
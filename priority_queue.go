@@ -0,0 +1,249 @@
+package go_list_like
+
+// A queue that always dequeues the value that no other value is less than, according to `greaterThan`
+//
+// The values are stored as a d-ary heap in a growable `RingBuffer`. As a `SliceLike`, the queue
+// presents its values in priority order, so index `0` is always the next value to be dequeued. The
+// values are sorted in place the first time they are read by index after a push, which keeps them
+// a valid heap, and dequeueing from sorted values simply advances the start of the ring buffer.
+// Because the order is fixed by priority, the queue is read-only as a `SliceLike`: `Set()`,
+// `Move()` and `MoveRange()` panic with `ErrReadOnly`, and `Slice()` returns a read-only `SortedView`
+//
+// Reading by index (`Get()`, `Slice()`, and the generic functions built on them, such as
+// `DequeueToSlice()`) after a push sorts every value, which costs O(N log N). Interleaving pushes
+// with indexed reads therefore costs O(N log N) per read, where `Peek()` and `Pop()` alone cost O(log N)
+type PriorityQueue[T any] struct {
+	ring        RingBuffer[T]
+	greaterThan func(a T, b T) (isGreaterThan bool)
+	arity       int
+	sorted      bool
+}
+
+// Create a binary priority queue that initially holds the provided values,
+// using the slice as the initial backing memory
+func NewPriorityQueue[T any](vals []T, greaterThan func(a T, b T) (isGreaterThan bool)) PriorityQueue[T] {
+	return NewPriorityQueueWithArity(vals, greaterThan, 2)
+}
+
+// Create a priority queue where each heap value has up to `arity` children
+//
+// Assumes `arity >= 2`
+func NewPriorityQueueWithArity[T any](vals []T, greaterThan func(a T, b T) (isGreaterThan bool), arity int) PriorityQueue[T] {
+	queue := PriorityQueue[T]{
+		ring:        NewRingBuffer(vals),
+		greaterThan: greaterThan,
+		arity:       arity,
+	}
+	HeapInitWithArity(&queue.ring, greaterThan, arity)
+	return queue
+}
+
+// Create an empty binary priority queue
+func EmptyPriorityQueue[T any](initCap int, greaterThan func(a T, b T) (isGreaterThan bool)) PriorityQueue[T] {
+	return PriorityQueue[T]{
+		ring:        EmptyRingBuffer[T](initCap),
+		greaterThan: greaterThan,
+		arity:       2,
+		sorted:      true,
+	}
+}
+
+// Sort the values into priority order if any have been pushed since they were last sorted
+func (queue *PriorityQueue[T]) ensureSorted() {
+	if queue.sorted {
+		return
+	}
+	Sort(&queue.ring, queue.greaterThan)
+	queue.sorted = true
+}
+
+// Add a value to the queue
+func (queue *PriorityQueue[T]) Push(val T) {
+	// Appending a value that is not less than the last sorted value keeps the values sorted
+	if queue.sorted && queue.ring.len > 0 && queue.greaterThan(queue.ring.Get(queue.ring.len-1), val) {
+		queue.sorted = false
+	}
+	HeapPushWithArity(&queue.ring, val, queue.greaterThan, queue.arity)
+}
+
+// Remove and return the value that no other value in the queue is less than
+//
+// Returns `false` if the queue is empty
+func (queue *PriorityQueue[T]) Pop() (val T, ok bool) {
+	if queue.sorted {
+		return queue.ring.PopFront()
+	}
+	return TryHeapPopWithArity(&queue.ring, queue.greaterThan, queue.arity)
+}
+
+// Return the value that no other value in the queue is less than, without removing it
+//
+// Returns `false` if the queue is empty
+func (queue *PriorityQueue[T]) Peek() (val T, ok bool) {
+	return TryHeapPeek(&queue.ring)
+}
+
+// Remove all values from the queue. The queue's capacity is retained.
+func (queue *PriorityQueue[T]) Clear() {
+	queue.ring.Clear()
+	queue.sorted = true
+}
+
+// Return the total number of values the queue can hold without reallocating
+func (queue *PriorityQueue[T]) Cap() int {
+	return queue.ring.Cap()
+}
+
+// SliceLike
+
+func (queue *PriorityQueue[T]) PreferLinearOps() bool {
+	return false
+}
+
+func (queue *PriorityQueue[T]) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (queue *PriorityQueue[T]) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (queue *PriorityQueue[T]) IdxValid(idx int) bool {
+	return queue.ring.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+func (queue *PriorityQueue[T]) RangeValid(firstIdx int, lastIdx int) bool {
+	return queue.ring.RangeValid(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (queue *PriorityQueue[T]) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	return queue.ring.SplitRange(firstIdx, lastIdx)
+}
+
+// Get the value at the provided index
+func (queue *PriorityQueue[T]) Get(idx int) (val T) {
+	queue.ensureSorted()
+	return queue.ring.Get(idx)
+}
+
+// Panics with `ErrReadOnly`, as changing a value could break the priority order
+func (queue *PriorityQueue[T]) Set(idx int, val T) {
+	panic(ErrReadOnly)
+}
+
+// Panics with `ErrReadOnly`, as the values are always kept in priority order
+func (queue *PriorityQueue[T]) Move(oldIdx int, newIdx int) {
+	panic(ErrReadOnly)
+}
+
+// Panics with `ErrReadOnly`, as the values are always kept in priority order
+func (queue *PriorityQueue[T]) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	panic(ErrReadOnly)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+//
+// The returned slice is a read-only `SortedView` that shares the same backing memory,
+// and no longer matches the queue once any value is pushed or popped
+func (queue *PriorityQueue[T]) Slice(firstIdx int, lastIdx int) (newSlice SliceLike[T, int]) {
+	queue.ensureSorted()
+	return NewSortedView(queue.ring.Slice(firstIdx, lastIdx), func(a T, b T) int {
+		switch {
+		case queue.greaterThan(a, b):
+			return 1
+		case queue.greaterThan(b, a):
+			return -1
+		}
+		return 0
+	})
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) FirstIdx() (idx int) {
+	return queue.ring.FirstIdx()
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) LastIdx() (idx int) {
+	return queue.ring.LastIdx()
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) NextIdx(thisIdx int) (nextIdx int) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) PrevIdx(thisIdx int) (prevIdx int) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (queue *PriorityQueue[T]) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (queue *PriorityQueue[T]) Len() int {
+	return queue.ring.Len()
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (queue *PriorityQueue[T]) LenBetween(firstIdx int, lastIdx int) int {
+	return queue.ring.LenBetween(firstIdx, lastIdx)
+}
+
+// QueueLike
+
+// Increment the start location (index/pointer/etc.) of this queue by
+// `n` positions. The new 'first' item in the queue should be the item
+// previously located at index `delta`
+//
+// Removes the `n` values that no other values in the queue are less than
+func (queue *PriorityQueue[T]) IncrementStart(n int) {
+	if queue.sorted {
+		queue.ring.IncrementStart(n)
+		return
+	}
+	n = min(n, queue.ring.len)
+	for range n {
+		HeapPopWithArity(&queue.ring, queue.greaterThan, queue.arity)
+	}
+}
+
+func (queue *PriorityQueue[T]) storage() (token any, known bool) {
+	return queue.ring.storage()
+}
+
+var _ QueueLike[byte, int] = (*PriorityQueue[byte])(nil)
@@ -0,0 +1,91 @@
+package go_list_like
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func Fuzz_Random_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, uint64(0), byte(3), byte(1))
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7}, uint64(1), byte(3), byte(2))
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, uint64(42), byte(20), byte(4))
+	f.Add([]byte{4, 8, 12, 16}, uint64(7), byte(5), byte(1))
+	f.Fuzz(func(t *testing.T, a []byte, seed uint64, k byte, chunk byte) {
+		newSrc := func() rand.Source {
+			return rand.NewPCG(seed, 1)
+		}
+		n := int(k) % (len(a) + 2)
+		chunkLen := 1 + int(chunk%8)
+		expSorted := slices.Clone(a)
+		slices.Sort(expSorted)
+		sameValues := func(got []byte) bool {
+			sorted := slices.Clone(got)
+			slices.Sort(sorted)
+			return slices.Equal(sorted, expSorted)
+		}
+		// Every value of `sub` appears in `a`, in the same order
+		isSubsequence := func(sub []byte) bool {
+			i := 0
+			for _, x := range a {
+				if i < len(sub) && sub[i] == x {
+					i += 1
+				}
+			}
+			return i == len(sub)
+		}
+		slice := NewSliceAdapter(slices.Clone(a))
+		Shuffle(&slice, newSrc())
+		list := NewLinkedList(slices.Clone(a))
+		Shuffle(&list, newSrc())
+		if !sameValues(slice.GoSlice()) || !slices.Equal(slice.GoSlice(), collectBytes(&list)) {
+			t.Errorf("\nFAIL: Shuffle() not a reproducible permutation\nEXP: %v\nGOT: %v and %v", a, slice.GoSlice(), collectBytes(&list))
+		}
+		list = NewLinkedList(slices.Clone(a))
+		dest := EmptySliceAdapter[byte](0)
+		nSampled := SampleWithoutReplacement(&list, n, &dest, newSrc())
+		again := EmptySliceAdapter[byte](0)
+		SampleWithoutReplacement(&list, n, &again, newSrc())
+		if nSampled != min(n, len(a)) || dest.Len() != nSampled || !isSubsequence(dest.GoSlice()) || !slices.Equal(dest.GoSlice(), again.GoSlice()) {
+			t.Errorf("\nFAIL: SampleWithoutReplacement(%d)\nFROM: %v\nGOT: %v and %v (%d sampled)", n, a, dest.GoSlice(), again.GoSlice(), nSampled)
+		}
+		queue := EmptyRingBuffer[byte](chunkLen)
+		reservoir := EmptyLinkedList[byte](0)
+		var nSeen uint64
+		for start := 0; start < len(a); start += chunkLen {
+			AppendVar(&queue, a[start:min(start+chunkLen, len(a))]...)
+			// A new source for each call, as a stream read over time would have
+			nSeen = ReservoirSample(&queue, &reservoir, n, nSeen, rand.NewPCG(seed, uint64(start)))
+			if queue.Len() != 0 {
+				t.Errorf("\nFAIL: ReservoirSample() left %d values in the queue", queue.Len())
+			}
+		}
+		got := collectBytes(&reservoir)
+		remaining := slices.Clone(a)
+		for _, x := range got {
+			pos := slices.Index(remaining, x)
+			if pos < 0 {
+				t.Errorf("\nFAIL: ReservoirSample(%d) chose values not in the stream\nFROM: %v\nGOT: %v", n, a, got)
+				break
+			}
+			remaining = slices.Delete(remaining, pos, pos+1)
+		}
+		if nSeen != uint64(len(a)) || len(got) != min(n, len(a)) {
+			t.Errorf("\nFAIL: ReservoirSample(%d)\nFROM: %v\nGOT: %v (%d seen)", n, a, got, nSeen)
+		}
+		weight := func(x byte) float64 {
+			return float64(x%4) - 1
+		}
+		anyPositive := slices.ContainsFunc(a, func(x byte) bool {
+			return weight(x) > 0
+		})
+		dest.Clear()
+		nSampled = WeightedSample(&slice, weight, n, &dest, newSrc())
+		if (anyPositive && nSampled != n) || (!anyPositive && nSampled != 0) || dest.Len() != nSampled || slices.ContainsFunc(dest.GoSlice(), func(x byte) bool {
+			return weight(x) <= 0
+		}) {
+			t.Errorf("\nFAIL: WeightedSample(%d)\nFROM: %v\nGOT: %v (%d sampled)", n, slice.GoSlice(), dest.GoSlice(), nSampled)
+		}
+	})
+}
@@ -0,0 +1,298 @@
+package go_list_like
+
+import "unsafe"
+
+// Encodes values of type `T` to, and decodes them from, a fixed number of bytes
+type RecordCodec[T any] interface {
+	// Return the number of bytes every encoded value occupies
+	Size() int
+	// Encode `val` into the first `Size()` bytes of `dest`
+	Encode(val T, dest []byte)
+	// Decode a value from the first `Size()` bytes of `src`
+	Decode(src []byte) (val T)
+}
+
+// Encodes any `Number` type as its in-memory bytes in little-endian order
+//
+// `int`, `uint`, and `uintptr` use their platform dependent size, so prefer the
+// explicitly sized types for data that must be read on other platforms
+type LittleEndianCodec[T Number] struct{}
+
+func (LittleEndianCodec[T]) Size() int {
+	var zero T
+	return int(unsafe.Sizeof(zero))
+}
+func (LittleEndianCodec[T]) Encode(val T, dest []byte) {
+	encodeNumber(val, dest, true)
+}
+func (LittleEndianCodec[T]) Decode(src []byte) (val T) {
+	return decodeNumber[T](src, true)
+}
+
+// Encodes any `Number` type as its in-memory bytes in big-endian order
+//
+// `int`, `uint`, and `uintptr` use their platform dependent size, so prefer the
+// explicitly sized types for data that must be read on other platforms
+type BigEndianCodec[T Number] struct{}
+
+func (BigEndianCodec[T]) Size() int {
+	var zero T
+	return int(unsafe.Sizeof(zero))
+}
+func (BigEndianCodec[T]) Encode(val T, dest []byte) {
+	encodeNumber(val, dest, false)
+}
+func (BigEndianCodec[T]) Decode(src []byte) (val T) {
+	return decodeNumber[T](src, false)
+}
+
+var nativeLittleEndian = func() bool {
+	var x uint16 = 1
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+func encodeNumber[T Number](val T, dest []byte, littleEndian bool) {
+	size := int(unsafe.Sizeof(val))
+	raw := unsafe.Slice((*byte)(unsafe.Pointer(&val)), size)
+	if littleEndian == nativeLittleEndian {
+		copy(dest[:size], raw)
+		return
+	}
+	for i := 0; i < size; i += 1 {
+		dest[i] = raw[size-1-i]
+	}
+}
+
+func decodeNumber[T Number](src []byte, littleEndian bool) (val T) {
+	size := int(unsafe.Sizeof(val))
+	raw := unsafe.Slice((*byte)(unsafe.Pointer(&val)), size)
+	if littleEndian == nativeLittleEndian {
+		copy(raw, src[:size])
+		return
+	}
+	for i := 0; i < size; i += 1 {
+		raw[i] = src[size-1-i]
+	}
+	return
+}
+
+// Presents a `SliceLike[byte, IDX]` as a `SliceLike[T, IDX]` of fixed-size records,
+// using a `RecordCodec[T]` to encode and decode each record
+//
+// Record `n` occupies the `codec.Size()` bytes starting at the `n * codec.Size()`th
+// byte of the underlying slice. Trailing bytes that do not make up a whole record are ignored.
+//
+// A record is decoded and encoded in place when the underlying slice exposes its memory as a
+// `GoSliceLike` or `ContiguousMemSliceLike`, and is otherwise transferred through a scratch buffer,
+// with a single `GetRange()`/`SetRange()` when the underlying slice is a `BulkSliceLike`, or one
+// byte at a time if not. The scratch buffer is shared by every copy of the view, so a view is
+// not safe for concurrent use
+type RecordView[T any, IDX Integer] struct {
+	bytes   SliceLike[byte, IDX]
+	codec   RecordCodec[T]
+	size    IDX
+	scratch []byte
+}
+
+func NewRecordView[T any, IDX Integer](bytes SliceLike[byte, IDX], codec RecordCodec[T]) RecordView[T, IDX] {
+	return RecordView[T, IDX]{
+		bytes:   bytes,
+		codec:   codec,
+		size:    IDX(codec.Size()),
+		scratch: make([]byte, codec.Size()),
+	}
+}
+
+// Return the underlying `SliceLike[byte, IDX]`
+func (view RecordView[T, IDX]) Bytes() SliceLike[byte, IDX] {
+	return view.bytes
+}
+
+// Return the byte index of the first byte of the record at `idx`
+func (view RecordView[T, IDX]) firstByteIdx(idx IDX) IDX {
+	return view.bytes.NthNextIdx(view.bytes.FirstIdx(), idx*view.size)
+}
+
+// Return the byte index of the last byte of the record at `idx`
+func (view RecordView[T, IDX]) lastByteIdx(idx IDX) IDX {
+	return view.bytes.NthNextIdx(view.firstByteIdx(idx), view.size-1)
+}
+
+// SliceLike
+
+func (view RecordView[T, IDX]) PreferLinearOps() bool {
+	return view.bytes.PreferLinearOps()
+}
+
+func (view RecordView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (view RecordView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (view RecordView[T, IDX]) IdxValid(idx IDX) bool {
+	return idx >= 0 && idx < view.Len()
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view RecordView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < view.Len()
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view RecordView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	return firstIdx + ((lastIdx - firstIdx) >> 1)
+}
+
+// Return the underlying slice as a `BulkSliceLike` if it is one and its indexes are consecutive
+func (view RecordView[T, IDX]) bulkBytes() (bulk BulkSliceLike[byte, IDX], ok bool) {
+	bulk, ok = view.bytes.(BulkSliceLike[byte, IDX])
+	ok = ok && view.bytes.ConsecutiveIndexesInOrder()
+	return
+}
+
+// Get the value at the provided index
+func (view RecordView[T, IDX]) Get(idx IDX) (val T) {
+	byteIdx := view.firstByteIdx(idx)
+	if span, ok := memSpan(view.bytes, byteIdx, int(view.size)); ok {
+		return view.codec.Decode(span)
+	}
+	buf := view.scratch
+	if bulk, ok := view.bulkBytes(); ok {
+		bulk.GetRange(byteIdx, byteIdx+view.size-1, buf)
+		return view.codec.Decode(buf)
+	}
+	for i := range buf {
+		buf[i] = view.bytes.Get(byteIdx)
+		byteIdx = view.bytes.NextIdx(byteIdx)
+	}
+	return view.codec.Decode(buf)
+}
+
+// Set the value at the provided index to the given value
+func (view RecordView[T, IDX]) Set(idx IDX, val T) {
+	byteIdx := view.firstByteIdx(idx)
+	if span, ok := memSpan(view.bytes, byteIdx, int(view.size)); ok {
+		view.codec.Encode(val, span)
+		return
+	}
+	buf := view.scratch
+	view.codec.Encode(val, buf)
+	if bulk, ok := view.bulkBytes(); ok {
+		bulk.SetRange(byteIdx, buf)
+		return
+	}
+	for i := range buf {
+		view.bytes.Set(byteIdx, buf[i])
+		byteIdx = view.bytes.NextIdx(byteIdx)
+	}
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view RecordView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	view.bytes.MoveRange(view.firstByteIdx(oldIdx), view.lastByteIdx(oldIdx), view.firstByteIdx(newIdx))
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view RecordView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	view.bytes.MoveRange(view.firstByteIdx(firstIdx), view.lastByteIdx(lastIdx), view.firstByteIdx(newFirstIdx))
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view RecordView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return RecordView[T, IDX]{
+		bytes:   view.bytes.Slice(view.firstByteIdx(firstIdx), view.lastByteIdx(lastIdx)),
+		codec:   view.codec,
+		size:    view.size,
+		scratch: view.scratch,
+	}
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) FirstIdx() (idx IDX) {
+	return 0
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) LastIdx() (idx IDX) {
+	return view.Len() - 1
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view RecordView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view RecordView[T, IDX]) Len() IDX {
+	return view.bytes.Len() / view.size
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view RecordView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	return (lastIdx - firstIdx) + 1
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view RecordView[T, IDX]) Err() error {
+	return CheckErr(view.bytes)
+}
+
+// Clear any error recorded by the underlying slice
+func (view RecordView[T, IDX]) ResetErr() {
+	ResetErr(view.bytes)
+}
+
+var _ SliceLike[uint32, int] = RecordView[uint32, int]{}
+var _ ErrorReporter = RecordView[uint32, int]{}
+var _ RecordCodec[float64] = LittleEndianCodec[float64]{}
+var _ RecordCodec[float64] = BigEndianCodec[float64]{}
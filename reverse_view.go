@@ -0,0 +1,292 @@
+package go_list_like
+
+// Presents a `SliceLike[T, IDX]` with its values in reverse order
+//
+// If the underlying slice has consecutive indexes, index `0` of the view refers to the
+// last value of the underlying slice. Otherwise the view reuses the underlying indexes,
+// and its consecutive indexes are no longer in order
+type ReverseView[T any, IDX Integer] struct {
+	slice       SliceLike[T, IDX]
+	consecutive bool
+}
+
+func NewReverseView[T any, IDX Integer, S SliceLike[T, IDX]](slice S) ReverseView[T, IDX] {
+	return ReverseView[T, IDX]{
+		slice:       slice,
+		consecutive: slice.ConsecutiveIndexesInOrder(),
+	}
+}
+
+// Return the underlying `SliceLike[T, IDX]`
+func (view ReverseView[T, IDX]) Inner() SliceLike[T, IDX] {
+	return view.slice
+}
+
+// Return the index of the underlying slice that `idx` refers to
+func (view ReverseView[T, IDX]) toInner(idx IDX) IDX {
+	if view.consecutive {
+		return view.slice.LastIdx() - idx
+	}
+	return idx
+}
+
+// Return the index of the view that refers to `innerIdx` of the underlying slice
+func (view ReverseView[T, IDX]) fromInner(innerIdx IDX) IDX {
+	if view.consecutive {
+		return view.slice.LastIdx() - innerIdx
+	}
+	return innerIdx
+}
+
+// SliceLike
+
+func (view ReverseView[T, IDX]) PreferLinearOps() bool {
+	return view.slice.PreferLinearOps()
+}
+
+func (view ReverseView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return view.consecutive
+}
+func (view ReverseView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return view.consecutive || view.slice.AllIndexesLessThanLenValid()
+}
+
+// Returns whether the given index is valid for the slice
+func (view ReverseView[T, IDX]) IdxValid(idx IDX) bool {
+	if view.consecutive {
+		return idx >= 0 && idx < view.slice.Len()
+	}
+	return view.slice.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view ReverseView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	if view.consecutive {
+		return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < view.slice.Len()
+	}
+	return view.slice.RangeValid(lastIdx, firstIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view ReverseView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	if view.consecutive {
+		return firstIdx + ((lastIdx - firstIdx) >> 1)
+	}
+	return view.slice.SplitRange(lastIdx, firstIdx)
+}
+
+// Get the value at the provided index
+func (view ReverseView[T, IDX]) Get(idx IDX) (val T) {
+	return view.slice.Get(view.toInner(idx))
+}
+
+// Set the value at the provided index to the given value
+func (view ReverseView[T, IDX]) Set(idx IDX, val T) {
+	view.slice.Set(view.toInner(idx), val)
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view ReverseView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	view.slice.Move(view.toInner(oldIdx), view.toInner(newIdx))
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view ReverseView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	// The range is reversed in the underlying slice, so its new first value
+	// lands where the new last value of the range would be in the view
+	n := view.LenBetween(firstIdx, lastIdx)
+	innerNewFirst := view.slice.NthPrevIdx(view.toInner(newFirstIdx), n-1)
+	view.slice.MoveRange(view.toInner(lastIdx), view.toInner(firstIdx), innerNewFirst)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view ReverseView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return NewReverseView(view.slice.Slice(view.toInner(lastIdx), view.toInner(firstIdx)))
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) FirstIdx() (idx IDX) {
+	if view.consecutive {
+		return 0
+	}
+	return view.slice.LastIdx()
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) LastIdx() (idx IDX) {
+	if view.consecutive {
+		return view.slice.Len() - 1
+	}
+	return view.slice.FirstIdx()
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + 1
+	}
+	return view.slice.PrevIdx(thisIdx)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + n
+	}
+	return view.slice.NthPrevIdx(thisIdx, n)
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	if view.consecutive {
+		return thisIdx - 1
+	}
+	return view.slice.NextIdx(thisIdx)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view ReverseView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	if view.consecutive {
+		return thisIdx - n
+	}
+	return view.slice.NthNextIdx(thisIdx, n)
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view ReverseView[T, IDX]) Len() IDX {
+	return view.slice.Len()
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view ReverseView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	if view.consecutive {
+		return (lastIdx - firstIdx) + 1
+	}
+	return view.slice.LenBetween(lastIdx, firstIdx)
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view ReverseView[T, IDX]) Err() error {
+	return CheckErr(view.slice)
+}
+
+// Clear any error recorded by the underlying slice
+func (view ReverseView[T, IDX]) ResetErr() {
+	ResetErr(view.slice)
+}
+
+// Presents a `ListLike[T, IDX]` with its values in reverse order
+//
+// Appending to the view inserts values at the start of the underlying list
+type ReverseListView[T any, IDX Integer] struct {
+	ReverseView[T, IDX]
+	list ListLike[T, IDX]
+}
+
+func NewReverseListView[T any, IDX Integer, L ListLike[T, IDX]](list L) ReverseListView[T, IDX] {
+	return ReverseListView[T, IDX]{
+		ReverseView: NewReverseView[T, IDX](list),
+		list:        list,
+	}
+}
+
+// ListLike
+
+// Ensure at least `n` empty capacity spaces exist to add new items without reallocating
+// the memory or perform any other expensive reorganization procedure
+//
+// If free space cannot be ensured and attempting to add `nMoreItems`
+// will definitely fail or cause undefined behaviour, `ok == false`
+func (view ReverseListView[T, IDX]) TryEnsureFreeSlots(nMoreItems IDX) (ok bool) {
+	return view.list.TryEnsureFreeSlots(nMoreItems)
+}
+
+// Insert `n` new slots directly before existing index, shifting all existing items
+// at and after that index forward. Returns the first new slot and the last new slot, inclusive.
+//
+// If `idx` is not valid, the slots are appended instead
+func (view ReverseListView[T, IDX]) InsertSlotsAssumeCapacity(idx IDX, count IDX) (firstNewSlot IDX, lastNewSlot IDX) {
+	if !view.IdxValid(idx) {
+		return view.AppendSlotsAssumeCapacity(count)
+	}
+	// Before `idx` in the view is after it in the underlying list
+	innerNext := view.list.NextIdx(view.toInner(idx))
+	var innerFirst, innerLast IDX
+	if view.list.IdxValid(innerNext) {
+		innerFirst, innerLast = view.list.InsertSlotsAssumeCapacity(innerNext, count)
+	} else {
+		innerFirst, innerLast = view.list.AppendSlotsAssumeCapacity(count)
+	}
+	return view.fromInner(innerLast), view.fromInner(innerFirst)
+}
+
+// Append `n` new slots at the end of the list.
+//
+// Returns the first new slot and the last new slot, inclusive.
+func (view ReverseListView[T, IDX]) AppendSlotsAssumeCapacity(count IDX) (firstNewSlot IDX, lastNewSlot IDX) {
+	innerFirst := view.list.FirstIdx()
+	var newInnerFirst, newInnerLast IDX
+	if view.list.IdxValid(innerFirst) {
+		newInnerFirst, newInnerLast = view.list.InsertSlotsAssumeCapacity(innerFirst, count)
+	} else {
+		newInnerFirst, newInnerLast = view.list.AppendSlotsAssumeCapacity(count)
+	}
+	return view.fromInner(newInnerLast), view.fromInner(newInnerFirst)
+}
+
+// Remove all items between `firstRemoveIdx` and `lastRemovedIdx`, inclusive
+//
+// All items after `lastRemovedIdx` are shifted backward
+func (view ReverseListView[T, IDX]) DeleteRange(firstRemovedIdx IDX, lastRemovedIdx IDX) {
+	view.list.DeleteRange(view.toInner(lastRemovedIdx), view.toInner(firstRemovedIdx))
+}
+
+// Reset list to an empty state. The list's capacity may or may not be retained.
+func (view ReverseListView[T, IDX]) Clear() {
+	view.list.Clear()
+}
+
+// Return the total number of values the slice/list can hold
+func (view ReverseListView[T, IDX]) Cap() IDX {
+	return view.list.Cap()
+}
+
+func (view ReverseView[T, IDX]) storage() (token any, known bool) {
+	return storageOf(view.slice)
+}
+
+var _ SliceLike[byte, int] = ReverseView[byte, int]{}
+var _ ErrorReporter = ReverseView[byte, int]{}
+var _ ListLike[byte, int] = ReverseListView[byte, int]{}
@@ -0,0 +1,422 @@
+package go_list_like
+
+// A double-ended queue that stores its items in a circular backing slice.
+//
+// Removing items from the front via `IncrementStart()` simply advances the
+// start position, so the capacity freed at the front is reused by later appends
+// instead of leaking as it does when reslicing a golang slice forward. A growable
+// buffer only reallocates when it is completely full, a fixed buffer never reallocates
+// and reports `TryEnsureFreeSlots() == false` instead.
+//
+// Indexes are logical positions from the front of the queue, so `0` is always the first item
+type RingBuffer[T any] struct {
+	data  []T
+	head  int
+	len   int
+	fixed bool
+}
+
+// Create a growable ring buffer that initially holds the provided values,
+// using the slice as the initial backing memory
+func NewRingBuffer[T any](vals []T) RingBuffer[T] {
+	return RingBuffer[T]{
+		data: vals[:cap(vals)],
+		len:  len(vals),
+	}
+}
+
+// Create an empty growable ring buffer
+func EmptyRingBuffer[T any](initCap int) RingBuffer[T] {
+	return RingBuffer[T]{
+		data: make([]T, initCap),
+	}
+}
+
+// Create an empty ring buffer that can never hold more than `capacity` items
+func FixedRingBuffer[T any](capacity int) RingBuffer[T] {
+	return RingBuffer[T]{
+		data:  make([]T, capacity),
+		fixed: true,
+	}
+}
+
+func (ring *RingBuffer[T]) PreferLinearOps() bool {
+	return false
+}
+
+func (ring *RingBuffer[T]) ConsecutiveIndexesInOrder() bool {
+	return true
+}
+func (ring *RingBuffer[T]) AllIndexesLessThanLenValid() bool {
+	return true
+}
+
+// Returns whether the given index is valid for the slice
+func (ring *RingBuffer[T]) IdxValid(idx int) bool {
+	return idx >= 0 && idx < ring.len
+}
+
+// Returns whether the given index range is valid for the slice
+func (ring *RingBuffer[T]) RangeValid(firstIdx int, lastIdx int) bool {
+	return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < ring.len
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (ring *RingBuffer[T]) SplitRange(firstIdx int, lastIdx int) (middleIdx int) {
+	return firstIdx + (((lastIdx + 1) - firstIdx) >> 1)
+}
+
+// Get the value at the provided index
+func (ring *RingBuffer[T]) Get(idx int) (val T) {
+	return ring.data[ring.physIdx(idx)]
+}
+
+// Set the value at the provided index to the given value
+func (ring *RingBuffer[T]) Set(idx int, val T) {
+	ring.data[ring.physIdx(idx)] = val
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (ring *RingBuffer[T]) Move(oldIdx int, newIdx int) {
+	val := ring.Get(oldIdx)
+	if newIdx < oldIdx {
+		for oldIdx > newIdx {
+			ring.Set(oldIdx, ring.Get(oldIdx-1))
+			oldIdx -= 1
+		}
+	} else {
+		for oldIdx < newIdx {
+			ring.Set(oldIdx, ring.Get(oldIdx+1))
+			oldIdx += 1
+		}
+	}
+	ring.Set(newIdx, val)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (ring *RingBuffer[T]) MoveRange(firstIdx int, lastIdx int, newFirstIdx int) {
+	lenA := (lastIdx - firstIdx) + 1
+	if newFirstIdx < firstIdx {
+		ring.reverse(firstIdx, lastIdx)
+		ring.reverse(newFirstIdx, firstIdx-1)
+		ring.reverse(newFirstIdx, lastIdx)
+	} else {
+		ring.reverse(firstIdx, lastIdx)
+		ring.reverse(lastIdx+1, (newFirstIdx+lenA)-1)
+		ring.reverse(firstIdx, (newFirstIdx+lenA)-1)
+	}
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+//
+// The returned slice is a fixed ring buffer sharing the same backing memory
+func (ring *RingBuffer[T]) Slice(firstIdx int, lastIdx int) (newSlice SliceLike[T, int]) {
+	return &RingBuffer[T]{
+		data:  ring.data,
+		head:  ring.physIdx(firstIdx),
+		len:   (lastIdx - firstIdx) + 1,
+		fixed: true,
+	}
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) FirstIdx() (idx int) {
+	return 0
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) LastIdx() (idx int) {
+	return ring.len - 1
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) NextIdx(thisIdx int) (nextIdx int) {
+	return thisIdx + 1
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) NthNextIdx(thisIdx int, n int) (nthNextIdx int) {
+	return thisIdx + n
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) PrevIdx(thisIdx int) (prevIdx int) {
+	return thisIdx - 1
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (ring *RingBuffer[T]) NthPrevIdx(thisIdx int, n int) (nthPrevIdx int) {
+	return thisIdx - n
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (ring *RingBuffer[T]) Len() int {
+	return ring.len
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (ring *RingBuffer[T]) LenBetween(firstIdx int, lastIdx int) int {
+	return min((lastIdx-firstIdx)+1, ring.len)
+}
+
+// Ensure at least `n` empty capacity spaces exist to add new items without reallocating
+// the memory or perform any other expensive reorganization procedure
+//
+// A fixed ring buffer never reallocates, and returns `false` if there is not enough free space
+func (ring *RingBuffer[T]) TryEnsureFreeSlots(nMoreItems int) (ok bool) {
+	needed := ring.len + nMoreItems
+	if needed <= len(ring.data) {
+		return true
+	}
+	if ring.fixed {
+		return false
+	}
+	newData := make([]T, max(needed, 2*len(ring.data), 8))
+	ring.copyOut(newData)
+	ring.data = newData
+	ring.head = 0
+	return true
+}
+
+// Insert `n` new slots at existing index, shifting all existing items
+// after them forward. Returns the first new slot and the last new slot, inclusive.
+// Whichever side of the insert index holds fewer items is the side that is shifted.
+//
+// The implementation should assume no checks need need to be made to ensure free space exists,
+// and calling this should not perform any reallocation
+func (ring *RingBuffer[T]) InsertSlotsAssumeCapacity(idx int, count int) (firstNewSlot int, lastNewSlot int) {
+	firstNewSlot = idx
+	lastNewSlot = idx + count - 1
+	if count <= 0 {
+		return
+	}
+	var zero T
+	if idx < ring.len-idx {
+		ring.head = ring.wrap(ring.head - count + len(ring.data))
+		ring.len += count
+		for i := 0; i < idx; i += 1 {
+			ring.Set(i, ring.Get(i+count))
+		}
+	} else {
+		ring.len += count
+		for i := ring.len - 1; i > lastNewSlot; i -= 1 {
+			ring.Set(i, ring.Get(i-count))
+		}
+	}
+	for i := firstNewSlot; i <= lastNewSlot; i += 1 {
+		ring.Set(i, zero)
+	}
+	return
+}
+
+// Append `n` new slots at the end of the list.
+//
+// Returns the first new slot and the last new slot, inclusive.
+//
+// The implementation should assume no checks need need to be made to ensure free space exists,
+// and calling this should not perform any reallocation
+func (ring *RingBuffer[T]) AppendSlotsAssumeCapacity(count int) (firstNewSlot int, lastNewSlot int) {
+	firstNewSlot = ring.len
+	lastNewSlot = firstNewSlot + count - 1
+	ring.len += count
+	var zero T
+	for i := firstNewSlot; i <= lastNewSlot; i += 1 {
+		ring.Set(i, zero)
+	}
+	return
+}
+
+// Remove all items between `firstRemoveIdx` and `lastRemovedIdx`, inclusive
+//
+// Whichever side of the removed range holds fewer items is the side that is shifted
+func (ring *RingBuffer[T]) DeleteRange(firstRemovedIdx int, lastRemovedIdx int) {
+	count := (lastRemovedIdx - firstRemovedIdx) + 1
+	var zero T
+	if firstRemovedIdx < ring.len-lastRemovedIdx-1 {
+		for i := lastRemovedIdx; i >= count; i -= 1 {
+			ring.Set(i, ring.Get(i-count))
+		}
+		for i := 0; i < count; i += 1 {
+			ring.Set(i, zero)
+		}
+		ring.head = ring.wrap(ring.head + count)
+	} else {
+		for i := firstRemovedIdx; i+count < ring.len; i += 1 {
+			ring.Set(i, ring.Get(i+count))
+		}
+		for i := ring.len - count; i < ring.len; i += 1 {
+			ring.Set(i, zero)
+		}
+	}
+	ring.len -= count
+}
+
+// Reset list to an empty state. The list's capacity is retained.
+func (ring *RingBuffer[T]) Clear() {
+	clear(ring.data)
+	ring.head = 0
+	ring.len = 0
+}
+
+// Return the total number of values the slice/list can hold
+func (ring *RingBuffer[T]) Cap() int {
+	return len(ring.data)
+}
+
+// Get the a pointer to the value at the provided index
+func (ring *RingBuffer[T]) GetPtr(idx int) *T {
+	return &ring.data[ring.physIdx(idx)]
+}
+
+// Return the `n` values starting at `firstIdx` as a golang slice that shares memory with the buffer,
+// or `ok == false` if they wrap around the end of the backing memory
+func (ring *RingBuffer[T]) ContiguousSpan(firstIdx int, n int) (span []T, ok bool) {
+	start := ring.physIdx(firstIdx)
+	if start+n > len(ring.data) {
+		return
+	}
+	return ring.data[start : start+n], true
+}
+
+// Increment the start location (index/pointer/etc.) of this queue by
+// `n` positions. The new 'first' item in the queue should be the item
+// previously located at index `delta`
+//
+// The freed slots at the front are reused by later appends
+func (ring *RingBuffer[T]) IncrementStart(n int) {
+	n = min(n, ring.len)
+	if n <= 0 {
+		return
+	}
+	var zero T
+	for i := 0; i < n; i += 1 {
+		ring.Set(i, zero)
+	}
+	ring.head = ring.wrap(ring.head + n)
+	ring.len -= n
+	if ring.len == 0 {
+		ring.head = 0
+	}
+}
+
+// Add a value to the front of the ring buffer
+//
+// Returns `false` if the buffer is fixed and already full
+func (ring *RingBuffer[T]) PushFront(val T) (ok bool) {
+	ok = ring.TryEnsureFreeSlots(1)
+	if !ok {
+		return
+	}
+	ring.head = ring.wrap(ring.head - 1 + len(ring.data))
+	ring.len += 1
+	ring.data[ring.head] = val
+	return
+}
+
+// Add a value to the back of the ring buffer
+//
+// Returns `false` if the buffer is fixed and already full
+func (ring *RingBuffer[T]) PushBack(val T) (ok bool) {
+	ok = ring.TryEnsureFreeSlots(1)
+	if !ok {
+		return
+	}
+	ring.len += 1
+	ring.Set(ring.len-1, val)
+	return
+}
+
+// Remove and return the value at the front of the ring buffer
+//
+// Returns `false` if the buffer is empty
+func (ring *RingBuffer[T]) PopFront() (val T, ok bool) {
+	ok = ring.len > 0
+	if !ok {
+		return
+	}
+	val = ring.data[ring.head]
+	ring.IncrementStart(1)
+	return
+}
+
+// Remove and return the value at the back of the ring buffer
+//
+// Returns `false` if the buffer is empty
+func (ring *RingBuffer[T]) PopBack() (val T, ok bool) {
+	ok = ring.len > 0
+	if !ok {
+		return
+	}
+	var zero T
+	last := ring.physIdx(ring.len - 1)
+	val = ring.data[last]
+	ring.data[last] = zero
+	ring.len -= 1
+	return
+}
+
+func (ring *RingBuffer[T]) wrap(physIdx int) int {
+	if physIdx >= len(ring.data) {
+		physIdx -= len(ring.data)
+	}
+	return physIdx
+}
+
+func (ring *RingBuffer[T]) physIdx(idx int) int {
+	return ring.wrap(ring.head + idx)
+}
+
+func (ring *RingBuffer[T]) reverse(firstIdx int, lastIdx int) {
+	for firstIdx < lastIdx {
+		a := ring.physIdx(firstIdx)
+		b := ring.physIdx(lastIdx)
+		ring.data[a], ring.data[b] = ring.data[b], ring.data[a]
+		firstIdx += 1
+		lastIdx -= 1
+	}
+}
+
+// Copy all items in logical order to the start of `dest`,
+// which must have a length of at least `ring.Len()`
+func (ring *RingBuffer[T]) copyOut(dest []T) {
+	n := copy(dest, ring.data[ring.head:min(ring.head+ring.len, len(ring.data))])
+	copy(dest[n:ring.len], ring.data)
+}
+
+// Slices of the buffer share its backing memory, so they report the same storage
+func (ring *RingBuffer[T]) storage() (token any, known bool) {
+	if len(ring.data) == 0 {
+		return nil, true
+	}
+	return &ring.data[0], true
+}
+
+var _ MemQueueLike[byte, int] = (*RingBuffer[byte])(nil)
+var _ MemListLike[byte, int] = (*RingBuffer[byte])(nil)
+var _ ContiguousMemSliceLike[byte, int] = (*RingBuffer[byte])(nil)
@@ -251,9 +251,20 @@ func (slice SliceAdapterIndirect[T]) GoSlice() []T {
 	return *slice.data
 }
 
+// Copy the values from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`
+func (slice SliceAdapterIndirect[T]) GetRange(firstIdx int, lastIdx int, dst []T) {
+	copy(dst, (*slice.data)[firstIdx:lastIdx+1])
+}
+
+// Set the `len(src)` values starting at `firstIdx` to the values in `src`
+func (slice SliceAdapterIndirect[T]) SetRange(firstIdx int, src []T) {
+	copy((*slice.data)[firstIdx:firstIdx+len(src)], src)
+}
+
 var _ MemQueueLike[byte, int] = SliceAdapterIndirect[byte]{}
 var _ MemListLike[byte, int] = SliceAdapterIndirect[byte]{}
 var _ GoSliceLike[byte] = SliceAdapterIndirect[byte]{}
+var _ BulkSliceLike[byte, int] = SliceAdapterIndirect[byte]{}
 var _ io.Reader = SliceAdapterIndirect[byte]{}
 var _ io.Writer = SliceAdapterIndirect[byte]{}
 var _ io.ReaderAt = SliceAdapterIndirect[byte]{}
@@ -529,9 +540,20 @@ func (slice *SliceAdapter[T]) GoSlice() []T {
 	return slice.data
 }
 
+// Copy the values from `firstIdx` to `lastIdx` (inclusive) into the start of `dst`
+func (slice *SliceAdapter[T]) GetRange(firstIdx int, lastIdx int, dst []T) {
+	copy(dst, slice.data[firstIdx:lastIdx+1])
+}
+
+// Set the `len(src)` values starting at `firstIdx` to the values in `src`
+func (slice *SliceAdapter[T]) SetRange(firstIdx int, src []T) {
+	copy(slice.data[firstIdx:firstIdx+len(src)], src)
+}
+
 var _ MemQueueLike[byte, int] = (*SliceAdapter[byte])(nil)
 var _ MemListLike[byte, int] = (*SliceAdapter[byte])(nil)
 var _ GoSliceLike[byte] = (*SliceAdapter[byte])(nil)
+var _ BulkSliceLike[byte, int] = (*SliceAdapter[byte])(nil)
 var _ io.Reader = (*SliceAdapter[byte])(nil)
 var _ io.Writer = (*SliceAdapter[byte])(nil)
 var _ io.ReaderAt = (*SliceAdapter[byte])(nil)
@@ -1,5 +1,7 @@
 package go_list_like
 
+import "math/bits"
+
 type SliceLike[T any, IDX Integer] interface {
 	// Should return a constant boolean value describing whether
 	// certain operations will peform better with linear operations
@@ -193,11 +195,23 @@ func TryNthNextIdx[T any, IDX Integer, S SliceLike[T, IDX]](slice S, thisIdx IDX
 	ok = slice.IdxValid(nextIdx)
 	return
 }
+
+// Return the index `n` places after the first index
+//
+// If `slice.PreferLinearOps() == true`, this walks with a `Cursor` from whichever of the first
+// or last index is closer, but still walks on every call, so when visiting many positions
+// hold a `Cursor` instead
 func NthIdx[T any, IDX Integer, S SliceLike[T, IDX]](slice S, n IDX) (nthIdx IDX) {
 	if n == 0 {
 		nthIdx = slice.FirstIdx()
 		return
 	}
+	if slice.PreferLinearOps() {
+		cursor := NewCursor(slice)
+		cursor.MoveTo(n)
+		nthIdx = cursor.Idx()
+		return
+	}
 	thisIdx := slice.FirstIdx()
 	nthIdx = slice.NthNextIdx(thisIdx, n)
 	return
@@ -211,6 +225,13 @@ func TryNthIdx[T any, IDX Integer, S SliceLike[T, IDX]](slice S, n IDX) (nthIdx
 		ok = slice.IdxValid(nthIdx)
 		return
 	}
+	if slice.PreferLinearOps() {
+		cursor := NewCursor(slice)
+		cursor.MoveTo(n)
+		nthIdx = cursor.Idx()
+		ok = cursor.Valid()
+		return
+	}
 	thisIdx := slice.FirstIdx()
 	ok = slice.IdxValid(thisIdx)
 	if !ok {
@@ -387,6 +408,9 @@ func TryOverwrite[T any, IDX Integer, S SliceLike[T, IDX]](slice S, oldIdx IDX,
 	return
 }
 func Reverse[T any, IDX Integer, S SliceLike[T, IDX]](slice S) {
+	if fastReverse(slice) {
+		return
+	}
 	left := slice.FirstIdx()
 	right := slice.LastIdx()
 	if left == right || !slice.IdxValid(left) || !slice.IdxValid(right) {
@@ -405,53 +429,40 @@ func Reverse[T any, IDX Integer, S SliceLike[T, IDX]](slice S) {
 	}
 }
 func Fill[T any, IDX Integer, S SliceLike[T, IDX]](slice S, val T) {
-	i := slice.FirstIdx()
-	ok := slice.IdxValid(i)
-	for ok {
-		slice.Set(i, val)
-		i = slice.NextIdx(i)
-	}
+	fillCountFromRange_internal(slice, slice.FirstIdx(), slice.LastIdx(), 0, false, val)
 }
 func FillCount[T any, IDX Integer, S SliceLike[T, IDX]](slice S, count IDX, val T) (nFilled IDX, ok bool) {
-	i := slice.FirstIdx()
-	ok = slice.IdxValid(i)
-	for ok {
-		slice.Set(i, val)
-		nFilled += 1
-		i = slice.NextIdx(i)
-	}
+	nFilled, _, _ = fillCountFromRange_internal(slice, slice.FirstIdx(), slice.LastIdx(), count, true, val)
 	ok = nFilled == count
 	return
 }
 func FillCountFromPos[T any, IDX Integer, S SliceLike[T, IDX]](slice S, startIdx IDX, count IDX, val T) (nFilled IDX, ok bool) {
-	i := startIdx
-	ok = slice.IdxValid(i)
-	for ok {
-		slice.Set(i, val)
-		nFilled += 1
-		i = slice.NextIdx(i)
-	}
+	nFilled, _, _ = fillCountFromRange_internal(slice, startIdx, slice.LastIdx(), count, true, val)
 	ok = nFilled == count
 	return
 }
 func FillFromPos[T any, IDX Integer, S SliceLike[T, IDX]](slice S, startIdx IDX, val T) (nFilled IDX) {
-	i := startIdx
-	ok := slice.IdxValid(i)
-	for ok {
-		slice.Set(i, val)
-		nFilled += 1
-		i = slice.NextIdx(i)
-	}
+	nFilled, _, _ = fillCountFromRange_internal(slice, startIdx, slice.LastIdx(), 0, false, val)
 	return
 }
 func FillRange[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX, val T) (nFilled IDX, fullRangeFilled bool, nextIdx IDX) {
+	nFilled, fullRangeFilled, nextIdx = fillCountFromRange_internal(slice, firstIdx, lastIdx, 0, false, val)
+	return
+}
+func fillCountFromRange_internal[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX, count IDX, forceCount bool, val T) (nFilled IDX, fullRangeFilled bool, nextIdx IDX) {
+	var fast bool
+	nFilled, fullRangeFilled, nextIdx, fast = fastFill(slice, firstIdx, lastIdx, count, forceCount, val)
+	if fast {
+		return
+	}
 	nextIdx = firstIdx
 	ok := slice.IdxValid(nextIdx)
-	for ok && !fullRangeFilled {
+	for (!forceCount || nFilled < count) && ok && !fullRangeFilled {
 		slice.Set(nextIdx, val)
 		nFilled += 1
 		fullRangeFilled = nextIdx == lastIdx
 		nextIdx = slice.NextIdx(nextIdx)
+		ok = slice.IdxValid(nextIdx)
 	}
 	return
 }
@@ -564,12 +575,17 @@ func CopyCountFromRangeToRange[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T
 	return
 }
 func copyCountFromRangeToRange_internal[T any, IDX1 Integer, IDX2 Integer, S1 SliceLike[T, IDX1], S2 SliceLike[T, IDX2]](source S1, firstSourceIdx IDX1, lastSourceIdx IDX1, dest S2, firstDestIdx IDX2, lastDestIdx IDX2, count IDX1, forceCount bool) (nCopied IDX1, fullSourceCopied bool, fullDestCopied bool, nextSourceIdx IDX1, nextDestIdx IDX2) {
+	var fast bool
+	nCopied, fullSourceCopied, fullDestCopied, nextSourceIdx, nextDestIdx, fast = fastCopy(source, firstSourceIdx, lastSourceIdx, dest, firstDestIdx, lastDestIdx, count, forceCount)
+	if fast {
+		return
+	}
 	nextSourceIdx = firstSourceIdx
 	nextDestIdx = firstDestIdx
 	ok1 := source.IdxValid(firstSourceIdx)
 	ok2 := dest.IdxValid(firstDestIdx)
 	var val T
-	for (!forceCount || count < nCopied) && ok1 && ok2 && !fullSourceCopied && !fullDestCopied {
+	for (!forceCount || nCopied < count) && ok1 && ok2 && !fullSourceCopied && !fullDestCopied {
 		val = source.Get(nextSourceIdx)
 		dest.Set(nextDestIdx, val)
 		fullSourceCopied = nextSourceIdx == lastSourceIdx
@@ -577,8 +593,8 @@ func copyCountFromRangeToRange_internal[T any, IDX1 Integer, IDX2 Integer, S1 Sl
 		nCopied += 1
 		nextSourceIdx = source.NextIdx(nextSourceIdx)
 		nextDestIdx = dest.NextIdx(nextDestIdx)
-		ok1 = source.IdxValid(firstSourceIdx)
-		ok2 = dest.IdxValid(firstDestIdx)
+		ok1 = source.IdxValid(nextSourceIdx)
+		ok2 = dest.IdxValid(nextDestIdx)
 	}
 	return
 }
@@ -600,6 +616,9 @@ func swizzleCount_internal[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 S
 	var sIdx IDX1
 	var dIdx IDX3 = dest.FirstIdx()
 	var moreDest = dest.IdxValid(dIdx)
+	// Slices that prefer linear ops keep a cursor, so that reaching position `nSwizzled`
+	// only walks from the position they were last selected at
+	var cursors map[IDX1]*Cursor[T, IDX1]
 	for (!forceCount || nSwizzled < count) && moreSelectors && moreDest {
 		sliceIdx = selectors.Get(idx)
 		selOk = slices.IdxValid(sliceIdx)
@@ -612,7 +631,21 @@ func swizzleCount_internal[T any, IDX1 Integer, IDX2 Integer, IDX3 Integer, S1 S
 		if !selOk {
 			break
 		}
-		sIdx = slice.NthNextIdx(sIdx, nSwizzled)
+		if slice.PreferLinearOps() {
+			if cursors == nil {
+				cursors = make(map[IDX1]*Cursor[T, IDX1])
+			}
+			cursor, seen := cursors[sliceIdx]
+			if !seen {
+				newCursor := NewCursor[T, IDX1](slice)
+				cursor = &newCursor
+				cursors[sliceIdx] = cursor
+			}
+			cursor.MoveTo(nSwizzled)
+			sIdx = cursor.Idx()
+		} else {
+			sIdx = slice.NthNextIdx(sIdx, nSwizzled)
+		}
 		selOk = slice.IdxValid(sIdx)
 		if !selOk {
 			break
@@ -708,6 +741,92 @@ func InsertionSortImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S)
 	InsertionSort(slice, GreaterThanImplicit)
 }
 
+// Sort the slice in O(n log n) time
+//
+// If `slice.PreferLinearOps() == false`, a pattern-defeating quicksort is performed in place using
+// only `Get()`, `Set()`, and `NthNextIdx()`. This sort is not stable.
+//
+// If `slice.PreferLinearOps() == true`, the values are copied out in index order, merge sorted,
+// and written back in index order. This sort is stable, but requires memory for two copies of the values
+func Sort[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	if slice.PreferLinearOps() {
+		linearMergeSort(slice, greaterThan)
+		return
+	}
+	first := slice.FirstIdx()
+	if !slice.IdxValid(first) {
+		return
+	}
+	n := int(slice.Len())
+	sorter := pdqSorter[T, IDX, S]{
+		slice:       slice,
+		first:       first,
+		greaterThan: greaterThan,
+	}
+	sorter.pdqsort(0, n, bits.Len(uint(n)))
+}
+
+func SortImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S) {
+	Sort(slice, GreaterThanImplicit)
+}
+
+// Sort the slice using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+//
+// See `Sort()` for details
+func SortFunc[T any, IDX Integer, S SliceLike[T, IDX]](slice S, compare func(a T, b T) int) {
+	Sort(slice, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Sort the slice in O(n log n) comparisons, keeping values that are
+// neither greater nor less than each other in their original order
+//
+// Runs are merged in place by rotation, using O(n log n log n) `Get()` and `Set()` calls and
+// no extra memory when `slice.ConsecutiveIndexesInOrder() == true`. Otherwise the slice's indexes
+// are collected into a table before sorting so that linked implementations are not repeatedly walked,
+// which requires O(n) extra memory for the table of `IDX` values
+func StableSort[T any, IDX Integer, S SliceLike[T, IDX]](slice S, greaterThan func(a T, b T) (isGreaterThan bool)) {
+	sorter, n := newStableSorter(slice, greaterThan)
+	sorter.sort(n)
+}
+
+func StableSortImplicit[T Ordered, IDX Integer, S SliceLike[T, IDX]](slice S) {
+	StableSort(slice, GreaterThanImplicit)
+}
+
+// Stable sort the slice using a comparison function that returns a negative number
+// when `a < b`, a positive number when `a > b`, and zero when they are equal
+//
+// See `StableSort()` for details, including the O(n) index table used when
+// `slice.ConsecutiveIndexesInOrder() == false`
+func StableSortFunc[T any, IDX Integer, S SliceLike[T, IDX]](slice S, compare func(a T, b T) int) {
+	StableSort(slice, func(a T, b T) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Identical to `StableSort()`, but runs are merged by copying them into `scratch`,
+// which requires O(n log n) `Get()` and `Set()` calls
+//
+// `scratch` is cleared before each merge and will need to hold up to half
+// of the slice's values. The same index table as `StableSort()` is used for slices whose
+// indexes are not consecutive. Any merge that cannot ensure enough free slots in `scratch`
+// falls back to merging in place
+func StableSortWithBuffer[T any, IDX Integer, IDX2 Integer, S SliceLike[T, IDX], L ListLike[T, IDX2]](slice S, greaterThan func(a T, b T) (isGreaterThan bool), scratch L) {
+	sorter, n := newStableSorter(slice, greaterThan)
+	sorter.bufferedMerge = stableBufferedMerge(sorter, scratch)
+	sorter.sort(n)
+}
+
+// See `StableSortFunc()` and `StableSortWithBuffer()`
+func StableSortFuncWithBuffer[T any, IDX Integer, IDX2 Integer, S SliceLike[T, IDX], L ListLike[T, IDX2]](slice S, compare func(a T, b T) int, scratch L) {
+	StableSortWithBuffer(slice, func(a T, b T) bool {
+		return compare(a, b) > 0
+	}, scratch)
+}
+
 func DoActionOnItemsUntilFalse[T any, IDX Integer, S SliceLike[T, IDX]](slice S, action func(slice S, idx IDX, item T) (shouldContinue bool)) (stopIdx IDX, actionCount IDX, stoppedAtEnd bool) {
 	var ok bool
 	var val T
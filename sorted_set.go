@@ -0,0 +1,363 @@
+package go_list_like
+
+// A read-only `SliceLike[T, IDX]` over a slice sorted in ascending order by `compare`,
+// providing the queries shared by `SortedSet` and `SortedMultiSet`
+//
+// `compare` returns a negative number when `a < b`, a positive number when `a > b`, and zero when
+// they are equal (as `cmp.Compare` does). `Set()`, `Move()` and `MoveRange()` panic with `ErrReadOnly`
+type SortedView[T any, IDX Integer] struct {
+	slice   SliceLike[T, IDX]
+	compare func(a T, b T) int
+}
+
+// Assumes `slice` is already sorted by `compare`
+func NewSortedView[T any, IDX Integer, S SliceLike[T, IDX]](slice S, compare func(a T, b T) int) SortedView[T, IDX] {
+	return SortedView[T, IDX]{
+		slice:   slice,
+		compare: compare,
+	}
+}
+
+// Return the position of `idx` from the first index, or `Len()` if it is not valid
+func (view SortedView[T, IDX]) position(idx IDX) IDX {
+	if !view.slice.IdxValid(idx) {
+		return view.slice.Len()
+	}
+	return view.slice.LenBetween(view.slice.FirstIdx(), idx) - 1
+}
+
+// Return whether a value equal to `val` is held
+func (view SortedView[T, IDX]) Contains(val T) bool {
+	_, found := LowerBound(view.slice, val, view.compare)
+	return found
+}
+
+// Return the number of values less than `val`
+func (view SortedView[T, IDX]) Rank(val T) (rank IDX) {
+	idx, _ := LowerBound(view.slice, val, view.compare)
+	return view.position(idx)
+}
+
+// Return the value that has exactly `k` values before it
+func (view SortedView[T, IDX]) Select(k IDX) (val T) {
+	return view.slice.Get(NthIdx(view.slice, k))
+}
+func (view SortedView[T, IDX]) TrySelect(k IDX) (val T, ok bool) {
+	idx, ok := TryNthIdx(view.slice, k)
+	if !ok {
+		return
+	}
+	val = view.slice.Get(idx)
+	return
+}
+
+// Return the first and last index (inclusive) of the values not less than `lo` and not greater than `hi`
+//
+// If no value is in the range, `found == false`
+func (view SortedView[T, IDX]) IdxRange(lo T, hi T) (firstIdx IDX, lastIdx IDX, found bool) {
+	firstIdx, _ = LowerBound(view.slice, lo, view.compare)
+	if !view.slice.IdxValid(firstIdx) || view.compare(view.slice.Get(firstIdx), hi) > 0 {
+		return
+	}
+	found = true
+	afterIdx := UpperBound(view.slice, hi, view.compare)
+	if view.slice.IdxValid(afterIdx) {
+		lastIdx = view.slice.PrevIdx(afterIdx)
+	} else {
+		lastIdx = view.slice.LastIdx()
+	}
+	return
+}
+
+// Return the number of values not less than `lo` and not greater than `hi`
+func (view SortedView[T, IDX]) CountRange(lo T, hi T) (count IDX) {
+	firstIdx, lastIdx, found := view.IdxRange(lo, hi)
+	if !found {
+		return 0
+	}
+	return view.slice.LenBetween(firstIdx, lastIdx)
+}
+
+// Append every value that is held or is in the sorted slice `other` to `dest`
+//
+// See `UnionSortedAppendToList()`
+func (view SortedView[T, IDX]) Union(other SliceLike[T, IDX], dest ListLike[T, IDX]) {
+	UnionSortedAppendToList(view.slice, other, dest, view.compare)
+}
+
+// Append every value that is both held and in the sorted slice `other` to `dest`
+//
+// See `IntersectSortedAppendToList()`
+func (view SortedView[T, IDX]) Intersect(other SliceLike[T, IDX], dest ListLike[T, IDX]) {
+	IntersectSortedAppendToList(view.slice, other, dest, view.compare)
+}
+
+// Append every value that is held but not in the sorted slice `other` to `dest`
+//
+// See `DifferenceSortedAppendToList()`
+func (view SortedView[T, IDX]) Difference(other SliceLike[T, IDX], dest ListLike[T, IDX]) {
+	DifferenceSortedAppendToList(view.slice, other, dest, view.compare)
+}
+
+// Append every value that is either held or in the sorted slice `other`, but not both, to `dest`
+//
+// See `SymmetricDifferenceSortedAppendToList()`
+func (view SortedView[T, IDX]) SymmetricDifference(other SliceLike[T, IDX], dest ListLike[T, IDX]) {
+	SymmetricDifferenceSortedAppendToList(view.slice, other, dest, view.compare)
+}
+
+// SliceLike
+
+func (view SortedView[T, IDX]) PreferLinearOps() bool {
+	return view.slice.PreferLinearOps()
+}
+
+func (view SortedView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return view.slice.ConsecutiveIndexesInOrder()
+}
+func (view SortedView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return view.slice.AllIndexesLessThanLenValid()
+}
+
+// Returns whether the given index is valid for the slice
+func (view SortedView[T, IDX]) IdxValid(idx IDX) bool {
+	return view.slice.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view SortedView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	return view.slice.RangeValid(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view SortedView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	return view.slice.SplitRange(firstIdx, lastIdx)
+}
+
+// Get the value at the provided index
+func (view SortedView[T, IDX]) Get(idx IDX) (val T) {
+	return view.slice.Get(idx)
+}
+
+// Panics with `ErrReadOnly`, as changing a value could break the sort order
+func (view SortedView[T, IDX]) Set(idx IDX, val T) {
+	panic(ErrReadOnly)
+}
+
+// Panics with `ErrReadOnly`, as moving a value could break the sort order
+func (view SortedView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	panic(ErrReadOnly)
+}
+
+// Panics with `ErrReadOnly`, as moving values could break the sort order
+func (view SortedView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	panic(ErrReadOnly)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+//
+// The returned slice is also a read-only `SortedView`
+func (view SortedView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return NewSortedView(view.slice.Slice(firstIdx, lastIdx), view.compare)
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) FirstIdx() (idx IDX) {
+	return view.slice.FirstIdx()
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) LastIdx() (idx IDX) {
+	return view.slice.LastIdx()
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	return view.slice.NextIdx(thisIdx)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	return view.slice.NthNextIdx(thisIdx, n)
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	return view.slice.PrevIdx(thisIdx)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view SortedView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	return view.slice.NthPrevIdx(thisIdx, n)
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view SortedView[T, IDX]) Len() IDX {
+	return view.slice.Len()
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view SortedView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	return view.slice.LenBetween(firstIdx, lastIdx)
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view SortedView[T, IDX]) Err() error {
+	return CheckErr(view.slice)
+}
+
+// Clear any error recorded by the underlying slice
+func (view SortedView[T, IDX]) ResetErr() {
+	ResetErr(view.slice)
+}
+
+// Insert `val` before `idx`, or append it if `idx` is not valid
+func sorted_InsertAt[T any, IDX Integer, L ListLike[T, IDX]](list L, idx IDX, val T) (newIdx IDX) {
+	if list.IdxValid(idx) {
+		newIdx, _ = InsertVar(list, idx, val)
+	} else {
+		newIdx, _ = AppendVar(list, val)
+	}
+	return
+}
+
+// A set of unique values kept sorted in ascending order in a backing `ListLike[T, IDX]`
+//
+// The set is itself a read-only `SliceLike[T, IDX]` (see `SortedView`). The backing list should
+// only be changed through the set. Lookups use a binary search unless `list.PreferLinearOps() == true`
+type SortedSet[T any, IDX Integer, L ListLike[T, IDX]] struct {
+	SortedView[T, IDX]
+	list L
+}
+
+// Create a set using `list` as storage, sorting its values by `compare` and removing any duplicates
+func NewSortedSet[T any, IDX Integer, L ListLike[T, IDX]](list L, compare func(a T, b T) int) SortedSet[T, IDX, L] {
+	SortFunc(list, compare)
+	UniqueSorted(list, func(a T, b T) bool {
+		return compare(a, b) == 0
+	})
+	return SortedSet[T, IDX, L]{
+		SortedView: NewSortedView[T, IDX](list, compare),
+		list:       list,
+	}
+}
+
+// Add `val` to the set if no equal value is already held
+//
+// Returns the index of the value equal to `val`, and whether it was added
+func (set SortedSet[T, IDX, L]) Add(val T) (idx IDX, added bool) {
+	idx, found := LowerBound(set.list, val, set.compare)
+	if found {
+		return
+	}
+	return sorted_InsertAt(set.list, idx, val), true
+}
+
+// Remove the value equal to `val` from the set, if held
+func (set SortedSet[T, IDX, L]) Remove(val T) (removed bool) {
+	idx, found := LowerBound(set.list, val, set.compare)
+	if !found {
+		return
+	}
+	set.list.DeleteRange(idx, idx)
+	return true
+}
+
+// Remove every value from the set
+func (set SortedSet[T, IDX, L]) Clear() {
+	set.list.Clear()
+}
+
+// A collection of values kept sorted in ascending order in a backing `ListLike[T, IDX]`,
+// where equal values may be held more than once
+//
+// Equal values are kept in the order they were added. The multiset is itself a read-only
+// `SliceLike[T, IDX]` (see `SortedView`). The backing list should only be changed through the multiset
+type SortedMultiSet[T any, IDX Integer, L ListLike[T, IDX]] struct {
+	SortedView[T, IDX]
+	list L
+}
+
+// Create a multiset using `list` as storage, stable sorting its values by `compare`
+func NewSortedMultiSet[T any, IDX Integer, L ListLike[T, IDX]](list L, compare func(a T, b T) int) SortedMultiSet[T, IDX, L] {
+	StableSortFunc(list, compare)
+	return SortedMultiSet[T, IDX, L]{
+		SortedView: NewSortedView[T, IDX](list, compare),
+		list:       list,
+	}
+}
+
+// Add `val` after any equal values already held, returning its index
+func (set SortedMultiSet[T, IDX, L]) Add(val T) (idx IDX) {
+	return sorted_InsertAt(set.list, UpperBound(set.list, val, set.compare), val)
+}
+
+// Remove the first value equal to `val`, if any are held
+func (set SortedMultiSet[T, IDX, L]) Remove(val T) (removed bool) {
+	idx, found := LowerBound(set.list, val, set.compare)
+	if !found {
+		return
+	}
+	set.list.DeleteRange(idx, idx)
+	return true
+}
+
+// Remove every value equal to `val`, returning how many were removed
+func (set SortedMultiSet[T, IDX, L]) RemoveAll(val T) (count IDX) {
+	firstIdx, lastIdx, found := EqualRange(set.list, val, set.compare)
+	if !found {
+		return
+	}
+	count = set.list.LenBetween(firstIdx, lastIdx)
+	set.list.DeleteRange(firstIdx, lastIdx)
+	return
+}
+
+// Return the number of values equal to `val`
+func (set SortedMultiSet[T, IDX, L]) Count(val T) (count IDX) {
+	return CountEqual(set.list, val, set.compare)
+}
+
+// Remove every value from the multiset
+func (set SortedMultiSet[T, IDX, L]) Clear() {
+	set.list.Clear()
+}
+
+func (view SortedView[T, IDX]) storage() (token any, known bool) {
+	return storageOf(view.slice)
+}
+
+var _ SliceLike[byte, int] = SortedView[byte, int]{}
+var _ ErrorReporter = SortedView[byte, int]{}
+var _ SliceLike[byte, int] = SortedSet[byte, int, *SliceAdapter[byte]]{}
+var _ SliceLike[byte, int] = SortedMultiSet[byte, int, *SliceAdapter[byte]]{}
@@ -0,0 +1,238 @@
+package go_list_like
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func Fuzz_SortedSet_(f *testing.F) {
+	f.Add([]byte{}, []byte{0, 5, 0, 3, 0, 5, 1, 3, 2, 4})
+	f.Add([]byte{9, 8, 7, 7, 6, 5, 4, 3, 3, 2, 1, 0}, []byte{1, 3, 1, 3, 2, 10, 0, 11, 3, 0, 4, 7})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, []byte{3, 0, 2, 5, 200, 1, 0, 77, 3, 2})
+	f.Fuzz(func(t *testing.T, initial []byte, ops []byte) {
+		slice := NewSliceAdapter(slices.Clone(initial))
+		checkSortedSetOps(t, "SliceAdapter", NewSortedSet[byte, int](&slice, cmp.Compare[byte]), initial, ops)
+		list := NewLinkedList(slices.Clone(initial))
+		checkSortedSetOps(t, "LinkedList", NewSortedSet[byte, int](&list, cmp.Compare[byte]), initial, ops)
+	})
+}
+
+// Applies `ops` to both the set and a sorted reference slice: `0` adds the next byte,
+// `1` removes the next byte, and any other op checks the queries against the next byte
+func checkSortedSetOps[L ListLike[byte, int]](t *testing.T, name string, set SortedSet[byte, int, L], initial []byte, ops []byte) {
+	expect := slices.Clone(initial)
+	slices.Sort(expect)
+	expect = slices.Compact(expect)
+	if !checkSortedView(t, name, "NewSortedSet", set.SortedView, expect, 0) {
+		return
+	}
+	for i := 0; i+1 < len(ops); i += 2 {
+		op, val := ops[i]%4, ops[i+1]
+		pos, found := slices.BinarySearch(expect, val)
+		switch op {
+		case 0:
+			idx, added := set.Add(val)
+			if added == found || set.Get(idx) != val {
+				t.Errorf("\nFAIL: %s SortedSet.Add(%d)\nEXP: added %t\nGOT: added %t, val %d", name, val, !found, added, set.Get(idx))
+				return
+			}
+			if !found {
+				expect = slices.Insert(expect, pos, val)
+			}
+		case 1:
+			if removed := set.Remove(val); removed != found {
+				t.Errorf("\nFAIL: %s SortedSet.Remove(%d)\nEXP: %t\nGOT: %t", name, val, found, removed)
+				return
+			}
+			if found {
+				expect = slices.Delete(expect, pos, pos+1)
+			}
+		}
+		if !checkSortedView(t, name, "op", set.SortedView, expect, val) {
+			return
+		}
+	}
+}
+
+func Fuzz_SortedMultiSet_(f *testing.F) {
+	f.Add([]byte{}, []byte{0, 5, 0, 5, 0, 3, 1, 5, 2, 3})
+	f.Add([]byte{9, 8, 7, 7, 6, 5, 4, 3, 3, 2, 1, 0}, []byte{1, 3, 2, 7, 0, 7, 3, 7, 0, 0, 1, 0})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109, 3, 3}, []byte{2, 3, 0, 3, 1, 3, 3, 200})
+	f.Fuzz(func(t *testing.T, initial []byte, ops []byte) {
+		slice := NewSliceAdapter(slices.Clone(initial))
+		checkSortedMultiSetOps(t, "SliceAdapter", NewSortedMultiSet[byte, int](&slice, cmp.Compare[byte]), initial, ops)
+		list := NewLinkedList(slices.Clone(initial))
+		checkSortedMultiSetOps(t, "LinkedList", NewSortedMultiSet[byte, int](&list, cmp.Compare[byte]), initial, ops)
+	})
+}
+
+// Applies `ops` to both the multiset and a sorted reference slice: `0` adds the next byte,
+// `1` removes one of the next byte, `2` removes all of the next byte,
+// and any other op checks the queries against the next byte
+func checkSortedMultiSetOps[L ListLike[byte, int]](t *testing.T, name string, set SortedMultiSet[byte, int, L], initial []byte, ops []byte) {
+	expect := slices.Clone(initial)
+	slices.Sort(expect)
+	if !checkSortedView(t, name, "NewSortedMultiSet", set.SortedView, expect, 0) {
+		return
+	}
+	for i := 0; i+1 < len(ops); i += 2 {
+		op, val := ops[i]%4, ops[i+1]
+		lo, found := slices.BinarySearch(expect, val)
+		hi := lo
+		for hi < len(expect) && expect[hi] == val {
+			hi += 1
+		}
+		if count := set.Count(val); count != hi-lo {
+			t.Errorf("\nFAIL: %s SortedMultiSet.Count(%d)\nEXP: %d\nGOT: %d", name, val, hi-lo, count)
+			return
+		}
+		switch op {
+		case 0:
+			if idx := set.Add(val); set.Get(idx) != val {
+				t.Errorf("\nFAIL: %s SortedMultiSet.Add(%d)\nGOT: val %d", name, val, set.Get(idx))
+				return
+			}
+			expect = slices.Insert(expect, hi, val)
+		case 1:
+			if removed := set.Remove(val); removed != found {
+				t.Errorf("\nFAIL: %s SortedMultiSet.Remove(%d)\nEXP: %t\nGOT: %t", name, val, found, removed)
+				return
+			}
+			if found {
+				expect = slices.Delete(expect, lo, lo+1)
+			}
+		case 2:
+			if count := set.RemoveAll(val); count != hi-lo {
+				t.Errorf("\nFAIL: %s SortedMultiSet.RemoveAll(%d)\nEXP: %d\nGOT: %d", name, val, hi-lo, count)
+				return
+			}
+			expect = slices.Delete(expect, lo, hi)
+		}
+		if !checkSortedView(t, name, "op", set.SortedView, expect, val) {
+			return
+		}
+	}
+}
+
+// Check the values and queries of `view` against the sorted slice `expect`, using `val` as the query value
+func checkSortedView(t *testing.T, name string, opName string, view SortedView[byte, int], expect []byte, val byte) bool {
+	got := make([]byte, 0, view.Len())
+	DoActionOnAllItems(view, func(view SortedView[byte, int], idx int, item byte) {
+		got = append(got, item)
+	})
+	if !slices.Equal(got, expect) {
+		t.Errorf("\nFAIL: %s %s() values mismatch\nEXP: %v\nGOT: %v", name, opName, expect, got)
+		return false
+	}
+	rank, found := slices.BinarySearch(expect, val)
+	if view.Contains(val) != found || view.Rank(val) != rank {
+		t.Errorf("\nFAIL: %s %s() Contains(%d)/Rank(%d)\nEXP: %t, %d\nGOT: %t, %d", name, opName, val, val, found, rank, view.Contains(val), view.Rank(val))
+		return false
+	}
+	if k := int(val); k < len(expect) && view.Select(k) != expect[k] {
+		t.Errorf("\nFAIL: %s %s() Select(%d)\nEXP: %d\nGOT: %d", name, opName, k, expect[k], view.Select(k))
+		return false
+	}
+	if _, ok := view.TrySelect(len(expect)); ok {
+		t.Errorf("\nFAIL: %s %s() TrySelect(%d) returned ok past the end", name, opName, len(expect))
+		return false
+	}
+	lo, hi := val/2, val
+	expCount := 0
+	for _, b := range expect {
+		if b >= lo && b <= hi {
+			expCount += 1
+		}
+	}
+	if count := view.CountRange(lo, hi); count != expCount {
+		t.Errorf("\nFAIL: %s %s() CountRange(%d, %d)\nEXP: %d\nGOT: %d", name, opName, lo, hi, expCount, count)
+		return false
+	}
+	return true
+}
+
+func Fuzz_SetOps_(f *testing.F) {
+	f.Add([]byte{}, []byte{1, 2, 3}, byte(0))
+	f.Add([]byte{1, 1, 2, 3, 3, 3, 5}, []byte{1, 3, 4, 5, 5}, byte(1))
+	f.Add([]byte{9, 8, 7, 7, 6}, []byte{}, byte(4))
+	f.Add([]byte{0, 2, 2, 4, 6, 8, 8, 8}, []byte{2, 8, 8}, byte(2))
+	f.Fuzz(func(t *testing.T, a []byte, b []byte, chunk byte) {
+		for i := range a {
+			a[i] %= 16
+		}
+		for i := range b {
+			b[i] %= 16
+		}
+		slices.Sort(a)
+		slices.Sort(b)
+		var countA, countB [16]int
+		for _, v := range a {
+			countA[v] += 1
+		}
+		for _, v := range b {
+			countB[v] += 1
+		}
+		expect := func(count func(x, y int) int) (out []byte) {
+			for v := range 16 {
+				for range count(countA[v], countB[v]) {
+					out = append(out, byte(v))
+				}
+			}
+			return
+		}
+		type toSliceFunc = func(a *RingBuffer[byte], b *LinkedList[byte], dest *SliceAdapter[byte], compare func(a, b byte) int) (int, int, int, bool, bool, bool)
+		type appendFunc = func(a *SliceAdapter[byte], b *LinkedList[byte], dest *SliceAdapter[byte], compare func(a, b byte) int) (int, int, int)
+		ops := []struct {
+			name    string
+			toSlice toSliceFunc
+			toList  appendFunc
+			expect  []byte
+		}{
+			{"MergeSorted", MergeSorted[byte, int, int, int, *RingBuffer[byte], *LinkedList[byte], *SliceAdapter[byte]], MergeSortedAppendToList[byte, int, int, int, *SliceAdapter[byte], *LinkedList[byte], *SliceAdapter[byte]], expect(func(x, y int) int { return x + y })},
+			{"UnionSorted", UnionSorted[byte, int, int, int, *RingBuffer[byte], *LinkedList[byte], *SliceAdapter[byte]], UnionSortedAppendToList[byte, int, int, int, *SliceAdapter[byte], *LinkedList[byte], *SliceAdapter[byte]], expect(func(x, y int) int { return max(x, y) })},
+			{"IntersectSorted", IntersectSorted[byte, int, int, int, *RingBuffer[byte], *LinkedList[byte], *SliceAdapter[byte]], IntersectSortedAppendToList[byte, int, int, int, *SliceAdapter[byte], *LinkedList[byte], *SliceAdapter[byte]], expect(func(x, y int) int { return min(x, y) })},
+			{"DifferenceSorted", DifferenceSorted[byte, int, int, int, *RingBuffer[byte], *LinkedList[byte], *SliceAdapter[byte]], DifferenceSortedAppendToList[byte, int, int, int, *SliceAdapter[byte], *LinkedList[byte], *SliceAdapter[byte]], expect(func(x, y int) int { return max(x-y, 0) })},
+			{"SymmetricDifferenceSorted", SymmetricDifferenceSorted[byte, int, int, int, *RingBuffer[byte], *LinkedList[byte], *SliceAdapter[byte]], SymmetricDifferenceSortedAppendToList[byte, int, int, int, *SliceAdapter[byte], *LinkedList[byte], *SliceAdapter[byte]], expect(func(x, y int) int { return max(x-y, y-x) })},
+		}
+		for _, op := range ops {
+			aSlice := NewSliceAdapter(slices.Clone(a))
+			bList := NewLinkedList(slices.Clone(b))
+			dest := EmptySliceAdapter[byte](0)
+			_, _, nAppended := op.toList(&aSlice, &bList, &dest, cmp.Compare[byte])
+			if !slices.Equal(dest.GoSlice(), op.expect) || nAppended != len(op.expect) {
+				t.Errorf("\nFAIL: %sAppendToList()\nA: %v\nB: %v\nEXP: %v\nGOT: %v (%d appended)", op.name, a, b, op.expect, dest.GoSlice(), nAppended)
+			}
+			// Stream both inputs as queues through a small destination, dropping what was consumed after each call
+			aQueue := NewRingBuffer(slices.Clone(a))
+			bQueue := NewLinkedList(slices.Clone(b))
+			var got []byte
+			for {
+				chunkDest := NewSliceAdapter(make([]byte, 1+int(chunk%4)))
+				nA, nB, nWritten, fullA, fullB, fullDest := op.toSlice(&aQueue, &bQueue, &chunkDest, cmp.Compare[byte])
+				if fullA != (nA == aQueue.Len()) || fullB != (nB == bQueue.Len()) || fullDest != (nWritten == chunkDest.Len()) {
+					t.Errorf("\nFAIL: %s() full flags\nA: %v\nB: %v\nGOT: consumed %d/%d %t, %d/%d %t, wrote %d/%d %t", op.name, a, b, nA, aQueue.Len(), fullA, nB, bQueue.Len(), fullB, nWritten, chunkDest.Len(), fullDest)
+					return
+				}
+				got = append(got, chunkDest.GoSlice()[:nWritten]...)
+				aQueue.IncrementStart(nA)
+				bQueue.IncrementStart(nB)
+				if !fullDest {
+					break
+				}
+			}
+			if !slices.Equal(got, op.expect) {
+				t.Errorf("\nFAIL: %s() streamed\nA: %v\nB: %v\nEXP: %v\nGOT: %v", op.name, a, b, op.expect, got)
+			}
+		}
+		expIncludes := true
+		for v := range 16 {
+			expIncludes = expIncludes && countB[v] <= countA[v]
+		}
+		aSlice := NewSliceAdapter(a)
+		bList := NewLinkedList(slices.Clone(b))
+		if _, nB, includes := IncludesSorted(&aSlice, &bList, cmp.Compare[byte]); includes != expIncludes || (includes && nB != len(b)) {
+			t.Errorf("\nFAIL: IncludesSorted()\nA: %v\nB: %v\nEXP: %t\nGOT: %t (%d consumed)", a, b, expIncludes, includes, nB)
+		}
+	})
+}
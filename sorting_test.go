@@ -1,6 +1,10 @@
 package go_list_like
 
 import (
+	"context"
+	"math"
+	"math/bits"
+	"os"
 	"slices"
 	"testing"
 )
@@ -138,9 +142,75 @@ func Fuzz_SortedSearch_(f *testing.F) {
 		if found && (foundIdx < minValidIdx || foundIdx > maxValidIdx) {
 			t.Errorf("\ntest case failed: found value idx outside valid range of matching bytes\nSEARCH VAL: %d\nSLICE: %v\nMIN VALID IDX: %d\nMAX VALID IDX: %d\n'FOUND' IDX: %d", b, a, minValidIdx, maxValidIdx, foundIdx)
 		}
+		list := NewLinkedList(slices.Clone(a))
+		compares := 0
+		countEqual := func(a, b byte) bool {
+			compares += 1
+			return a == b
+		}
+		listIdx, listFound := SortedSearch(&list, b, countEqual, GreaterThanImplicit)
+		listPos := list.LenBetween(list.FirstIdx(), listIdx) - 1
+		if listFound != existsInList || (listFound && (listPos < minValidIdx || listPos > maxValidIdx)) {
+			t.Errorf("\ntest case failed: LinkedList search mismatch\nSEARCH VAL: %d\nSLICE: %v\nEXP: found %t in [%d, %d]\nGOT: found %t at %d\n", b, a, existsInList, minValidIdx, maxValidIdx, listFound, listPos)
+		}
+		// Galloping then bisecting tests at most about twice as many values as a binary search
+		if maxCompares := 2*bits.Len(uint(len(a))) + 2; compares > maxCompares {
+			t.Errorf("\ntest case failed: LinkedList search compared too many values\nSLICE: %v\nEXP: <= %d\nGOT: %d\n", a, maxCompares, compares)
+		}
+	})
+}
+
+func Fuzz_Bounds_(f *testing.F) {
+	f.Add([]byte{}, byte(5))
+	f.Add([]byte{0, 1, 2, 3, 4}, byte(5))
+	f.Add([]byte{5, 5, 5, 5, 6}, byte(5))
+	f.Add([]byte{1, 3, 5, 5, 5, 7, 9}, byte(5))
+	f.Add([]byte{6, 6, 6, 6}, byte(5))
+	f.Fuzz(func(t *testing.T, a []byte, b byte) {
+		slices.Sort(a)
+		expLower, expFound := slices.BinarySearch(a, b)
+		expUpper := expLower
+		for expUpper < len(a) && a[expUpper] == b {
+			expUpper += 1
+		}
+		aa := NewSliceAdapter(a)
+		checkBounds(t, "SliceAdapter", &aa, a, b, expLower, expUpper, expFound)
+		list := NewLinkedList(slices.Clone(a))
+		checkBounds(t, "LinkedList", &list, a, b, expLower, expUpper, expFound)
 	})
 }
 
+func checkBounds[S SliceLike[byte, int]](t *testing.T, name string, slice S, a []byte, b byte, expLower int, expUpper int, expFound bool) {
+	// Converts an index into its position from the first index, or `len(a)` if it is not valid
+	pos := func(idx int) int {
+		if !slice.IdxValid(idx) {
+			return len(a)
+		}
+		return slice.LenBetween(slice.FirstIdx(), idx) - 1
+	}
+	lower, found := LowerBoundImplicit(slice, b)
+	if pos(lower) != expLower || found != expFound {
+		t.Errorf("\nFAIL: %s LowerBound(%d)\nSLICE: %v\nEXP: %d, %t\nGOT: %d, %t", name, b, a, expLower, expFound, pos(lower), found)
+	}
+	searchIdx, found := SearchFunc(slice, func(item byte) int {
+		return int(item) - int(b)
+	})
+	if searchIdx != lower || found != expFound {
+		t.Errorf("\nFAIL: %s SearchFunc(%d)\nSLICE: %v\nEXP: %d, %t\nGOT: %d, %t", name, b, a, lower, expFound, searchIdx, found)
+	}
+	upper := UpperBoundImplicit(slice, b)
+	if pos(upper) != expUpper {
+		t.Errorf("\nFAIL: %s UpperBound(%d)\nSLICE: %v\nEXP: %d\nGOT: %d", name, b, a, expUpper, pos(upper))
+	}
+	first, last, found := EqualRangeImplicit(slice, b)
+	if found != expFound || (found && (pos(first) != expLower || pos(last) != expUpper-1)) {
+		t.Errorf("\nFAIL: %s EqualRange(%d)\nSLICE: %v\nEXP: %d, %d, %t\nGOT: %d, %d, %t", name, b, a, expLower, expUpper-1, expFound, pos(first), pos(last), found)
+	}
+	if count := CountEqualImplicit(slice, b); count != expUpper-expLower {
+		t.Errorf("\nFAIL: %s CountEqual(%d)\nSLICE: %v\nEXP: %d\nGOT: %d", name, b, a, expUpper-expLower, count)
+	}
+}
+
 // func Fuzz_SortedSetAndResort(f *testing.F) {
 // 	f.Add([]byte{0, 1, 2, 3, 4}, byte(5), int(1))
 // 	f.Add([]byte{1, 2, 3, 4}, byte(5), int(2))
@@ -175,3 +245,354 @@ func Fuzz_SortedSearch_(f *testing.F) {
 // 		}
 // 	})
 // }
+
+func Fuzz_Sort_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4})
+	f.Add([]byte{4, 3, 2, 1, 0})
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5})
+	f.Fuzz(func(t *testing.T, a []byte) {
+		expect := slices.Clone(a)
+		slices.Sort(expect)
+		aa := slices.Clone(a)
+		aaa := NewSliceAdapterIndirect(&aa)
+		SortImplicit(aaa)
+		if !slices.Equal(expect, aa) {
+			t.Errorf("\ntest case failed: SliceAdapterIndirect not sorted\nEXP: %v\nGOT: %v\n", expect, aa)
+		}
+		bb := slices.Clone(a)
+		bbb := NewSliceAdapterIndirect(&bb)
+		SortFunc(bbb, func(x byte, y byte) int {
+			return int(y) - int(x)
+		})
+		slices.Reverse(bb)
+		if !slices.Equal(expect, bb) {
+			t.Errorf("\ntest case failed: SortFunc descending not sorted\nEXP: %v\nGOT: %v\n", expect, bb)
+		}
+		list := NewLinkedList(slices.Clone(a))
+		SortImplicit(&list)
+		got := make([]byte, 0, len(a))
+		DoActionOnAllItems(&list, func(slice *LinkedList[byte], idx int, item byte) {
+			got = append(got, item)
+		})
+		if !slices.Equal(expect, got) {
+			t.Errorf("\ntest case failed: LinkedList not sorted\nEXP: %v\nGOT: %v\n", expect, got)
+		}
+	})
+}
+
+func Fuzz_StableSort_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4})
+	f.Add([]byte{0x41, 0x32, 0x43, 0x14, 0x35, 0x16, 0x47})
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5})
+	f.Fuzz(func(t *testing.T, a []byte) {
+		// Only the high 4 bits are compared, so the low 4 bits reveal whether equal values kept their order
+		compare := func(x byte, y byte) int {
+			return int(x>>4) - int(y>>4)
+		}
+		expect := slices.Clone(a)
+		slices.SortStableFunc(expect, compare)
+		checkStable := func(name string, got []byte) {
+			if !slices.Equal(expect, got) {
+				t.Errorf("\ntest case failed: %s not stable sorted\nEXP: %v\nGOT: %v\n", name, expect, got)
+			}
+		}
+		aa := slices.Clone(a)
+		StableSortFunc(NewSliceAdapterIndirect(&aa), compare)
+		checkStable("StableSortFunc(SliceAdapterIndirect)", aa)
+		bb := slices.Clone(a)
+		var scratch []byte
+		StableSortFuncWithBuffer(NewSliceAdapterIndirect(&bb), compare, NewSliceAdapterIndirect(&scratch))
+		checkStable("StableSortFuncWithBuffer(SliceAdapterIndirect)", bb)
+		listToSlice := func(list *LinkedList[byte]) []byte {
+			got := make([]byte, 0, len(a))
+			DoActionOnAllItems(list, func(slice *LinkedList[byte], idx int, item byte) {
+				got = append(got, item)
+			})
+			return got
+		}
+		list := NewLinkedList(slices.Clone(a))
+		StableSortFunc(&list, compare)
+		checkStable("StableSortFunc(LinkedList)", listToSlice(&list))
+		list = NewLinkedList(slices.Clone(a))
+		scratchList := EmptyLinkedList[byte](0)
+		StableSortFuncWithBuffer(&list, compare, &scratchList)
+		checkStable("StableSortFuncWithBuffer(LinkedList)", listToSlice(&list))
+	})
+}
+
+func Fuzz_ExternalSort_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, 4)
+	f.Add([]byte{0x41, 0x32, 0x43, 0x14, 0x35, 0x16, 0x47}, 2)
+	manyRuns := make([]byte, 300)
+	for i := range manyRuns {
+		manyRuns[i] = byte(i * 37)
+	}
+	f.Add(manyRuns, 2)
+	f.Fuzz(func(t *testing.T, a []byte, memoryBudget int) {
+		memoryBudget = max(1, memoryBudget%64)
+		// Only the high 4 bits are compared, so the low 4 bits reveal whether equal values kept their order
+		greaterThan := func(x byte, y byte) bool {
+			return x>>4 > y>>4
+		}
+		expect := slices.Clone(a)
+		slices.SortStableFunc(expect, func(x byte, y byte) int {
+			return int(x>>4) - int(y>>4)
+		})
+		tempDir := t.TempDir()
+		lastDone, lastTotal := -1, -1
+		ctx := WithExternalSortProgress(context.Background(), func(phase ExternalSortPhase, recordsDone int, recordsTotal int) {
+			if phase == ExternalSortWritingOutput {
+				lastDone, lastTotal = recordsDone, recordsTotal
+			}
+		})
+		got := slices.Clone(a)
+		gotList := NewSliceAdapterIndirect(&got)
+		err := ExternalSort(ctx, gotList, gotList, greaterThan, LittleEndianCodec[byte]{}, memoryBudget, tempDir)
+		if err != nil {
+			t.Errorf("\ntest case failed: ExternalSort returned error: %s\n", err)
+		}
+		if !slices.Equal(expect, got) {
+			t.Errorf("\ntest case failed: not stable sorted\nEXP: %v\nGOT: %v\n", expect, got)
+		}
+		if len(a) > 0 && (lastDone != len(a) || lastTotal != len(a)) {
+			t.Errorf("\ntest case failed: final progress not reported\nEXP: %d / %d\nGOT: %d / %d\n", len(a), len(a), lastDone, lastTotal)
+		}
+		leftover, _ := os.ReadDir(tempDir)
+		if len(leftover) != 0 {
+			t.Errorf("\ntest case failed: %d temporary files were not removed\n", len(leftover))
+		}
+		// Ring buffers report their storage, so up to `ExternalSortMaxFanIn` runs merge straight into `dest`
+		mergedRuns := false
+		ctx = WithExternalSortProgress(context.Background(), func(phase ExternalSortPhase, recordsDone int, recordsTotal int) {
+			if phase == ExternalSortMergingRuns {
+				mergedRuns = true
+			}
+		})
+		ringSource := newRotatedRingBuffer(slices.Clone(a), byte(memoryBudget))
+		ringDest := EmptyRingBuffer[byte](0)
+		err = ExternalSort(ctx, ringSource, &ringDest, greaterThan, LittleEndianCodec[byte]{}, memoryBudget, tempDir)
+		if got := collectBytes(&ringDest); err != nil || !slices.Equal(expect, got) {
+			t.Errorf("\ntest case failed: ExternalSort into a separate RingBuffer\nEXP: %v\nGOT: %v (%v)\n", expect, got, err)
+		}
+		nRuns := (len(a) + memoryBudget - 1) / memoryBudget
+		if mergedRuns != (nRuns > ExternalSortMaxFanIn) {
+			t.Errorf("\ntest case failed: ExternalSort into a separate RingBuffer merged runs before writing\nEXP: %t for %d runs\nGOT: %t\n", nRuns > ExternalSortMaxFanIn, nRuns, mergedRuns)
+		}
+		if len(a) == 0 {
+			return
+		}
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+		got = slices.Clone(a)
+		err = ExternalSort(cancelled, gotList, gotList, greaterThan, LittleEndianCodec[byte]{}, memoryBudget, tempDir)
+		if err != context.Canceled {
+			t.Errorf("\ntest case failed: cancelled ExternalSort\nEXP: %v\nGOT: %v\n", context.Canceled, err)
+		}
+		if !slices.Equal(a, got) {
+			t.Errorf("\ntest case failed: cancelled ExternalSort modified dest\nEXP: %v\nGOT: %v\n", a, got)
+		}
+	})
+}
+
+// Cancels part way through each merging and writing phase with `source == dest`, which must
+// either leave the list untouched or finish sorting it, but never lose values
+func Test_ExternalSortCancel_(t *testing.T) {
+	a := make([]byte, 3*externalSortCheckInterval)
+	for i := range a {
+		a[i] = byte(i*37 + i/256)
+	}
+	expect := slices.Clone(a)
+	slices.Sort(expect)
+	for _, cancelPhase := range []ExternalSortPhase{ExternalSortMergingRuns, ExternalSortWritingOutput} {
+		tempDir := t.TempDir()
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx = WithExternalSortProgress(ctx, func(phase ExternalSortPhase, recordsDone int, recordsTotal int) {
+			if phase == cancelPhase && recordsDone < recordsTotal {
+				cancel()
+			}
+		})
+		got := slices.Clone(a)
+		gotList := NewSliceAdapterIndirect(&got)
+		err := ExternalSort(ctx, gotList, gotList, GreaterThanImplicit[byte], LittleEndianCodec[byte]{}, 1000, tempDir)
+		cancel()
+		switch cancelPhase {
+		case ExternalSortMergingRuns:
+			if err != context.Canceled || !slices.Equal(a, got) {
+				t.Errorf("\ntest case failed: ExternalSort cancelled while merging\nEXP: %v and dest untouched\nGOT: %v and dest changed %t\n", context.Canceled, err, !slices.Equal(a, got))
+			}
+		case ExternalSortWritingOutput:
+			if err != nil || !slices.Equal(expect, got) {
+				t.Errorf("\ntest case failed: ExternalSort cancelled while writing\nEXP: <nil> and dest sorted\nGOT: %v and dest sorted %t\n", err, slices.Equal(expect, got))
+			}
+		}
+		leftover, _ := os.ReadDir(tempDir)
+		if len(leftover) != 0 {
+			t.Errorf("\ntest case failed: %d temporary files were not removed\n", len(leftover))
+		}
+	}
+}
+
+func Fuzz_Select_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice, byte(0), byte(1))
+	f.Add([]byte{0, 1, 2, 3, 4}, byte(2), byte(2))
+	f.Add([]byte{56, 42, 3, 77, 22, 5, 109}, byte(6), byte(3))
+	f.Add([]byte{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 5, 5, 5}, byte(13), byte(5))
+	f.Fuzz(func(t *testing.T, a []byte, k byte, chunk byte) {
+		expect := slices.Clone(a)
+		slices.Sort(expect)
+		n := int(k) % (len(a) + 1)
+		chunkLen := 1 + int(chunk%8)
+		for _, linked := range []bool{false, true} {
+			name := "SliceAdapter"
+			newSlice := func(vals []byte) SliceLike[byte, int] {
+				slice := NewSliceAdapter(vals)
+				return &slice
+			}
+			if linked {
+				name = "LinkedList"
+				newSlice = func(vals []byte) SliceLike[byte, int] {
+					list := NewLinkedList(vals)
+					return &list
+				}
+			}
+			toSlice := func(slice SliceLike[byte, int]) (vals []byte) {
+				DoActionOnAllItems(slice, func(slice SliceLike[byte, int], idx int, item byte) {
+					vals = append(vals, item)
+				})
+				return
+			}
+			sameValues := func(got []byte) bool {
+				sorted := slices.Clone(got)
+				slices.Sort(sorted)
+				return slices.Equal(sorted, expect)
+			}
+			slice := newSlice(slices.Clone(a))
+			nthIdx := NthElementImplicit(slice, n)
+			got := toSlice(slice)
+			if n < len(a) {
+				if slice.Get(nthIdx) != expect[n] || got[n] != expect[n] {
+					t.Errorf("\ntest case failed: %s NthElement(%d) wrong value\nEXP: %d\nGOT: %v\n", name, n, expect[n], got)
+				}
+				for i, v := range got {
+					if (i < n && v > got[n]) || (i > n && v < got[n]) {
+						t.Errorf("\ntest case failed: %s NthElement(%d) not partitioned\nGOT: %v\n", name, n, got)
+						break
+					}
+				}
+			} else if slice.IdxValid(nthIdx) || !slices.Equal(got, a) {
+				t.Errorf("\ntest case failed: %s NthElement(%d) past the end changed the slice\nGOT: %v\n", name, n, got)
+			}
+			if !sameValues(got) {
+				t.Errorf("\ntest case failed: %s NthElement(%d) lost values\nEXP: %v\nGOT: %v\n", name, n, expect, got)
+			}
+			slice = newSlice(slices.Clone(a))
+			PartialSortFunc(slice, n, func(x byte, y byte) int {
+				return int(x) - int(y)
+			})
+			got = toSlice(slice)
+			if !slices.Equal(got[:n], expect[:n]) || !sameValues(got) {
+				t.Errorf("\ntest case failed: %s PartialSort(%d)\nEXP: %v\nGOT: %v\n", name, n, expect[:n], got)
+			}
+			source := newSlice(slices.Clone(a))
+			dest := newSlice([]byte{255}).(ListLike[byte, int])
+			nAppended := PartialSortCopyImplicit(source, dest, n)
+			got = toSlice(dest)
+			if nAppended != n || !slices.Equal(got, append([]byte{255}, expect[:n]...)) || !slices.Equal(toSlice(source), a) {
+				t.Errorf("\ntest case failed: %s PartialSortCopy(%d)\nEXP: %v\nGOT: %v (%d appended)\n", name, n, expect[:n], got, nAppended)
+			}
+			queue := EmptyRingBuffer[byte](chunkLen)
+			dest = newSlice(nil).(ListLike[byte, int])
+			for start := 0; start < len(a); start += chunkLen {
+				AppendVar(&queue, a[start:min(start+chunkLen, len(a))]...)
+				if nConsumed := TopKImplicit(&queue, dest, n); nConsumed != min(chunkLen, len(a)-start) || queue.Len() != 0 {
+					t.Errorf("\ntest case failed: %s TopK(%d) consumed %d, left %d\n", name, n, nConsumed, queue.Len())
+				}
+			}
+			got = toSlice(dest)
+			slices.Sort(got)
+			if !slices.Equal(got, expect[len(a)-n:]) {
+				t.Errorf("\ntest case failed: %s TopK(%d)\nEXP: %v\nGOT: %v\n", name, n, expect[len(a)-n:], got)
+			}
+		}
+	})
+}
+
+func Fuzz_RadixSort_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice)
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7})
+	f.Add([]byte{0x41, 0x32, 0x43, 0x14, 0x35, 0x16, 0x47, 0xFF, 0x80, 0x7F, 0x00, 0x01})
+	f.Add([]byte{0x00, 0x00, 0xC0, 0x7F, 0x00, 0x00, 0x00, 0x80, 0x00, 0x00, 0x80, 0xFF, 0x00, 0x00, 0x80, 0x7F, 0x01, 0x00, 0x00, 0x00})
+	f.Fuzz(func(t *testing.T, a []byte) {
+		ints := make([]int16, len(a)/2)
+		for i := range ints {
+			ints[i] = int16(a[2*i]) | int16(a[2*i+1])<<8
+		}
+		uints := make([]uint64, len(a)/3)
+		for i := range uints {
+			uints[i] = uint64(a[3*i]) | uint64(a[3*i+1])<<29 | uint64(a[3*i+2])<<56
+		}
+		floats := make([]float32, len(a)/4)
+		for i := range floats {
+			floats[i] = math.Float32frombits(uint32(a[4*i]) | uint32(a[4*i+1])<<8 | uint32(a[4*i+2])<<16 | uint32(a[4*i+3])<<24)
+		}
+		strs := make([]string, 0, len(a)/2)
+		for start := 0; start < len(a); start += 2 + int(a[start]%3) {
+			strs = append(strs, string(a[start:min(start+int(a[start]%4), len(a))]))
+		}
+		checkRadixSort(t, "int16", ints, func(s *SliceAdapter[int16], scratch *SliceAdapter[int16]) { RadixSort(s, scratch) }, func(s *LinkedList[int16], scratch *LinkedList[int16]) { RadixSort(s, scratch) })
+		checkRadixSort(t, "uint64", uints, func(s *SliceAdapter[uint64], scratch *SliceAdapter[uint64]) { RadixSort(s, scratch) }, func(s *LinkedList[uint64], scratch *LinkedList[uint64]) { RadixSort(s, scratch) })
+		checkRadixSort(t, "float32", floats, func(s *SliceAdapter[float32], scratch *SliceAdapter[float32]) { RadixSort(s, scratch) }, func(s *LinkedList[float32], scratch *LinkedList[float32]) { RadixSort(s, scratch) })
+		checkRadixSort(t, "string", strs, func(s *SliceAdapter[string], scratch *SliceAdapter[string]) { RadixSortString(s, scratch) }, func(s *LinkedList[string], scratch *LinkedList[string]) { RadixSortString(s, scratch) })
+		// Only the high 4 bits are used as the key, so the low 4 bits reveal whether equal keys kept their order
+		key := func(x byte) int8 {
+			return int8(x) >> 4
+		}
+		expect := slices.Clone(a)
+		slices.SortStableFunc(expect, func(x byte, y byte) int {
+			return int(key(x)) - int(key(y))
+		})
+		got := NewSliceAdapter(slices.Clone(a))
+		scratch := EmptyLinkedList[byte](0)
+		RadixSortByKey(&got, &scratch, key)
+		if !slices.Equal(expect, got.GoSlice()) {
+			t.Errorf("\ntest case failed: RadixSortByKey not stable sorted\nEXP: %v\nGOT: %v\n", expect, got.GoSlice())
+		}
+	})
+}
+
+// Check that `sortSlice()` and `sortList()` sort `vals` in the same order as `slices.Sort()`,
+// using a slice with memory and a linked list
+func checkRadixSort[T Ordered](t *testing.T, name string, vals []T, sortSlice func(s *SliceAdapter[T], scratch *SliceAdapter[T]), sortList func(s *LinkedList[T], scratch *LinkedList[T])) {
+	expect := slices.Clone(vals)
+	slices.Sort(expect)
+	// NaN values are never equal to themselves, so compare the order of their bits instead
+	equal := func(got []T) bool {
+		return slices.EqualFunc(expect, got, func(x T, y T) bool {
+			return x == y || (x != x && y != y)
+		})
+	}
+	slice := NewSliceAdapter(slices.Clone(vals))
+	scratch := NewSliceAdapter(make([]T, 3))
+	sortSlice(&slice, &scratch)
+	if !equal(slice.GoSlice()) {
+		t.Errorf("\ntest case failed: %s SliceAdapter not radix sorted\nEXP: %v\nGOT: %v\n", name, expect, slice.GoSlice())
+	}
+	list := NewLinkedList(slices.Clone(vals))
+	scratchList := EmptyLinkedList[T](0)
+	sortList(&list, &scratchList)
+	got := make([]T, 0, len(vals))
+	DoActionOnAllItems(&list, func(list *LinkedList[T], idx int, item T) {
+		got = append(got, item)
+	})
+	if !equal(got) {
+		t.Errorf("\ntest case failed: %s LinkedList not radix sorted\nEXP: %v\nGOT: %v\n", name, expect, got)
+	}
+}
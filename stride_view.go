@@ -0,0 +1,223 @@
+package go_list_like
+
+// Presents every `step`th value of a `SliceLike[T, IDX]`, starting with the value
+// `offset` places after its first index
+//
+// `Move()` and `MoveRange()` rearrange only the values that are part of the view,
+// leaving the values between them where they are
+type StrideView[T any, IDX Integer] struct {
+	slice       SliceLike[T, IDX]
+	step        IDX
+	offset      IDX
+	consecutive bool
+}
+
+// Assumes `step >= 1` and `offset >= 0`
+func NewStrideView[T any, IDX Integer, S SliceLike[T, IDX]](slice S, step IDX, offset IDX) StrideView[T, IDX] {
+	return StrideView[T, IDX]{
+		slice:       slice,
+		step:        step,
+		offset:      offset,
+		consecutive: slice.ConsecutiveIndexesInOrder(),
+	}
+}
+
+// Return the underlying `SliceLike[T, IDX]`
+func (view StrideView[T, IDX]) Inner() SliceLike[T, IDX] {
+	return view.slice
+}
+
+// Return the index of the underlying slice that `idx` refers to
+func (view StrideView[T, IDX]) toInner(idx IDX) IDX {
+	if view.consecutive {
+		return view.slice.FirstIdx() + view.offset + (idx * view.step)
+	}
+	return idx
+}
+
+// SliceLike
+
+func (view StrideView[T, IDX]) PreferLinearOps() bool {
+	return view.slice.PreferLinearOps()
+}
+
+func (view StrideView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return view.consecutive
+}
+func (view StrideView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return view.consecutive
+}
+
+// Returns whether the given index is valid for the slice
+func (view StrideView[T, IDX]) IdxValid(idx IDX) bool {
+	if view.consecutive {
+		return idx >= 0 && idx < view.Len()
+	}
+	return view.slice.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view StrideView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	if view.consecutive {
+		return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < view.Len()
+	}
+	return view.slice.RangeValid(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view StrideView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	if view.consecutive {
+		return firstIdx + ((lastIdx - firstIdx) >> 1)
+	}
+	return view.NthNextIdx(firstIdx, (view.LenBetween(firstIdx, lastIdx)-1)>>1)
+}
+
+// Get the value at the provided index
+func (view StrideView[T, IDX]) Get(idx IDX) (val T) {
+	return view.slice.Get(view.toInner(idx))
+}
+
+// Set the value at the provided index to the given value
+func (view StrideView[T, IDX]) Set(idx IDX, val T) {
+	view.slice.Set(view.toInner(idx), val)
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view StrideView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	moveRangeByValues_internal(view, oldIdx, oldIdx, newIdx)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view StrideView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	moveRangeByValues_internal(view, firstIdx, lastIdx, newFirstIdx)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view StrideView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return NewStrideView(view.slice.Slice(view.toInner(firstIdx), view.toInner(lastIdx)), view.step, 0)
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) FirstIdx() (idx IDX) {
+	if view.consecutive {
+		return 0
+	}
+	return view.slice.NthNextIdx(view.slice.FirstIdx(), view.offset)
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) LastIdx() (idx IDX) {
+	n := view.Len()
+	if view.consecutive {
+		return n - 1
+	}
+	if n == 0 {
+		return invalidIdx(view.slice)
+	}
+	lastPos := view.offset + ((n - 1) * view.step)
+	return view.slice.NthPrevIdx(view.slice.LastIdx(), view.slice.Len()-1-lastPos)
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + 1
+	}
+	return view.slice.NthNextIdx(thisIdx, view.step)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + n
+	}
+	return view.slice.NthNextIdx(thisIdx, n*view.step)
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	return view.NthPrevIdx(thisIdx, 1)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view StrideView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	if view.consecutive {
+		return thisIdx - n
+	}
+	nthPrevIdx = view.slice.NthPrevIdx(thisIdx, n*view.step)
+	// Values less than `offset` places after the first index are not part of the view
+	if !view.slice.IdxValid(view.slice.NthPrevIdx(nthPrevIdx, view.offset)) {
+		return invalidIdx(view.slice)
+	}
+	return
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view StrideView[T, IDX]) Len() IDX {
+	innerLen := view.slice.Len()
+	if innerLen <= view.offset {
+		return 0
+	}
+	return ((innerLen - view.offset - 1) / view.step) + 1
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view StrideView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	if view.consecutive {
+		return (lastIdx - firstIdx) + 1
+	}
+	innerLen := view.slice.LenBetween(firstIdx, lastIdx)
+	if innerLen <= 0 {
+		return 0
+	}
+	return ((innerLen - 1) / view.step) + 1
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view StrideView[T, IDX]) Err() error {
+	return CheckErr(view.slice)
+}
+
+// Clear any error recorded by the underlying slice
+func (view StrideView[T, IDX]) ResetErr() {
+	ResetErr(view.slice)
+}
+
+func (view StrideView[T, IDX]) storage() (token any, known bool) {
+	return storageOf(view.slice)
+}
+
+var _ SliceLike[byte, int] = StrideView[byte, int]{}
+var _ ErrorReporter = StrideView[byte, int]{}
@@ -0,0 +1,65 @@
+package go_list_like
+
+import (
+	"slices"
+	"testing"
+)
+
+func Fuzz_Unique_(f *testing.F) {
+	var nilSlice []byte
+	f.Add(nilSlice)
+	f.Add([]byte{0, 0, 1, 2, 2, 2, 3, 4, 4})
+	f.Add([]byte{56, 42, 3, 77, 42, 5, 109, 3, 3})
+	f.Add([]byte{0x41, 0x32, 0x43, 0x14, 0x35, 0x16, 0x47, 0x41})
+	f.Fuzz(func(t *testing.T, a []byte) {
+		// Only the high 4 bits are used as the key, so the low 4 bits reveal which value was kept
+		key := func(x byte) byte {
+			return x >> 4
+		}
+		expCompact := slices.Compact(slices.Clone(a))
+		var expStable, expByKey []byte
+		var expDuplicates []int
+		seen := map[byte]bool{}
+		seenKeys := map[byte]bool{}
+		for i, x := range a {
+			if !seen[x] {
+				expStable = append(expStable, x)
+			} else {
+				expDuplicates = append(expDuplicates, i)
+			}
+			if !seenKeys[key(x)] {
+				expByKey = append(expByKey, x)
+			}
+			seen[x] = true
+			seenKeys[key(x)] = true
+		}
+		newLists := newByteLists(byte(len(a)))
+		for name, newList := range newLists {
+			list := newList(slices.Clone(a))
+			nRemoved := UniqueSortedImplicit(list)
+			if got := collectBytes(list); !slices.Equal(got, expCompact) || nRemoved != len(a)-len(expCompact) {
+				t.Errorf("\nFAIL: %s UniqueSorted()\nEXP: %v\nGOT: %v (%d removed)", name, expCompact, got, nRemoved)
+			}
+			list = newList(slices.Clone(a))
+			nRemoved = UniqueStable(list)
+			if got := collectBytes(list); !slices.Equal(got, expStable) || nRemoved != len(a)-len(expStable) {
+				t.Errorf("\nFAIL: %s UniqueStable()\nEXP: %v\nGOT: %v (%d removed)", name, expStable, got, nRemoved)
+			}
+			list = newList(slices.Clone(a))
+			nRemoved = UniqueStableByKey(list, key)
+			if got := collectBytes(list); !slices.Equal(got, expByKey) || nRemoved != len(a)-len(expByKey) {
+				t.Errorf("\nFAIL: %s UniqueStableByKey()\nEXP: %v\nGOT: %v (%d removed)", name, expByKey, got, nRemoved)
+			}
+			list = newList(slices.Clone(a))
+			dest := NewSliceAdapter([]int{-1})
+			nDuplicates := DuplicatesInto(list, &dest)
+			gotPositions := make([]int, 0, nDuplicates)
+			for _, idx := range dest.GoSlice()[1:] {
+				gotPositions = append(gotPositions, list.LenBetween(list.FirstIdx(), idx)-1)
+			}
+			if !slices.Equal(gotPositions, expDuplicates) || nDuplicates != len(expDuplicates) || !slices.Equal(collectBytes(list), a) {
+				t.Errorf("\nFAIL: %s DuplicatesInto()\nEXP: %v\nGOT: %v (%d reported)", name, expDuplicates, gotPositions, nDuplicates)
+			}
+		}
+	})
+}
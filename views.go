@@ -0,0 +1,55 @@
+package go_list_like
+
+import "slices"
+
+// The view types (`ReverseView`, `StrideView`, `ConcatView`, `WindowView`, and `MapView`) present
+// one or more existing `SliceLike`s in a different shape without copying any values. Every access
+// to a view reads or writes the underlying slices directly, so changes made through either are
+// immediately visible through the other
+//
+// When an underlying slice has `ConsecutiveIndexesInOrder() == true`, views over it number their
+// values from `0` to `Len() - 1` and translate each index with simple arithmetic. Otherwise, the
+// view reuses the indexes of the underlying slice, so that walking the view with `NextIdx()` costs
+// the same as walking the underlying slice. In that case `IdxValid()` only checks that an index is
+// valid for the underlying slice, so only indexes obtained from the view should be passed to it
+
+// Return an index that is not valid for `slice`
+func invalidIdx[T any, IDX Integer, S SliceLike[T, IDX]](slice S) IDX {
+	return slice.NextIdx(slice.LastIdx())
+}
+
+// Perform `MoveRange()` by reading every affected value and writing them back in their new order
+//
+// Used by views whose values are not adjacent in the underlying slice, where moving the underlying
+// values would also move values that are not part of the view
+func moveRangeByValues_internal[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	first := slice.FirstIdx()
+	firstPos := slice.LenBetween(first, firstIdx) - 1
+	newFirstPos := slice.LenBetween(first, newFirstIdx) - 1
+	if firstPos == newFirstPos {
+		return
+	}
+	n := int(slice.LenBetween(firstIdx, lastIdx))
+	loIdx, loPos, hiPos := firstIdx, firstPos, newFirstPos+IDX(n-1)
+	if newFirstPos < firstPos {
+		loIdx, loPos, hiPos = newFirstIdx, newFirstPos, firstPos+IDX(n-1)
+	}
+	vals := make([]T, int(hiPos-loPos)+1)
+	idx := loIdx
+	for i := range vals {
+		vals[i] = slice.Get(idx)
+		idx = slice.NextIdx(idx)
+	}
+	split := n
+	if newFirstPos < firstPos {
+		split = len(vals) - n
+	}
+	slices.Reverse(vals[:split])
+	slices.Reverse(vals[split:])
+	slices.Reverse(vals)
+	idx = loIdx
+	for _, val := range vals {
+		slice.Set(idx, val)
+		idx = slice.NextIdx(idx)
+	}
+}
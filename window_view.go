@@ -0,0 +1,235 @@
+package go_list_like
+
+// Presents the values from `firstIdx` to `lastIdx` (inclusive) of a `SliceLike[T, IDX]`
+//
+// Unlike `Slice()`, the window works the same way for every implementation and never
+// copies or re-links any values. The window is fixed to the underlying indexes it was
+// created with, so inserting or deleting values in the underlying slice does not move it
+type WindowView[T any, IDX Integer] struct {
+	slice       SliceLike[T, IDX]
+	first       IDX
+	last        IDX
+	consecutive bool
+}
+
+// Assumes `RangeValid(firstIdx, lastIdx) == true`, or that `firstIdx` and `lastIdx`
+// are `slice.FirstIdx()` and `slice.LastIdx()` of an empty slice
+func NewWindowView[T any, IDX Integer, S SliceLike[T, IDX]](slice S, firstIdx IDX, lastIdx IDX) WindowView[T, IDX] {
+	return WindowView[T, IDX]{
+		slice:       slice,
+		first:       firstIdx,
+		last:        lastIdx,
+		consecutive: slice.ConsecutiveIndexesInOrder(),
+	}
+}
+
+// Return the underlying `SliceLike[T, IDX]`
+func (view WindowView[T, IDX]) Inner() SliceLike[T, IDX] {
+	return view.slice
+}
+
+// Return the index of the underlying slice that `idx` refers to
+func (view WindowView[T, IDX]) toInner(idx IDX) IDX {
+	if view.consecutive {
+		return view.first + idx
+	}
+	return idx
+}
+
+// SliceLike
+
+func (view WindowView[T, IDX]) PreferLinearOps() bool {
+	return view.slice.PreferLinearOps()
+}
+
+func (view WindowView[T, IDX]) ConsecutiveIndexesInOrder() bool {
+	return view.consecutive
+}
+func (view WindowView[T, IDX]) AllIndexesLessThanLenValid() bool {
+	return view.consecutive
+}
+
+// Returns whether the given index is valid for the slice
+func (view WindowView[T, IDX]) IdxValid(idx IDX) bool {
+	if view.consecutive {
+		return idx >= 0 && idx < view.Len()
+	}
+	return view.slice.IdxValid(idx)
+}
+
+// Returns whether the given index range is valid for the slice
+//
+// The following MUST be true:
+//   - `firstIdx` comes logically before OR is equal to `lastIdx`
+//   - all indexes including and between `firstIdx` and `lastIdx` are valid for the slice
+func (view WindowView[T, IDX]) RangeValid(firstIdx IDX, lastIdx IDX) bool {
+	if view.consecutive {
+		return firstIdx >= 0 && firstIdx <= lastIdx && lastIdx < view.Len()
+	}
+	return view.slice.RangeValid(firstIdx, lastIdx)
+}
+
+// Split an index range in half, returning the index in the middle of the range
+//
+// Assumes `RangeValid(firstIdx, lastIdx) == true`
+func (view WindowView[T, IDX]) SplitRange(firstIdx IDX, lastIdx IDX) (middleIdx IDX) {
+	if view.consecutive {
+		return firstIdx + ((lastIdx - firstIdx) >> 1)
+	}
+	return view.slice.SplitRange(firstIdx, lastIdx)
+}
+
+// Get the value at the provided index
+func (view WindowView[T, IDX]) Get(idx IDX) (val T) {
+	return view.slice.Get(view.toInner(idx))
+}
+
+// Set the value at the provided index to the given value
+func (view WindowView[T, IDX]) Set(idx IDX, val T) {
+	view.slice.Set(view.toInner(idx), val)
+}
+
+// Move the data located at `oldIdx` to `newIdx`, shifting all
+// values in between either up or down
+func (view WindowView[T, IDX]) Move(oldIdx IDX, newIdx IDX) {
+	view.MoveRange(oldIdx, oldIdx, newIdx)
+}
+
+// Remove all data contained in range `firstIdx` to `lastIdx` (inclusive),
+// and re-insert it at the `newFirstIdx` position
+func (view WindowView[T, IDX]) MoveRange(firstIdx IDX, lastIdx IDX, newFirstIdx IDX) {
+	if view.consecutive {
+		view.slice.MoveRange(view.toInner(firstIdx), view.toInner(lastIdx), view.toInner(newFirstIdx))
+		return
+	}
+	// Re-linking would carry the window's own end indexes along with the moved values
+	moveRangeByValues_internal(view, firstIdx, lastIdx, newFirstIdx)
+}
+
+// Return another SliceLike[T, I] that holds values in range [first, last]
+//
+// Analogous to slice[first:last+1]
+func (view WindowView[T, IDX]) Slice(firstIdx IDX, lastIdx IDX) (slice SliceLike[T, IDX]) {
+	return NewWindowView(view.slice, view.toInner(firstIdx), view.toInner(lastIdx))
+}
+
+// Return the first index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) FirstIdx() (idx IDX) {
+	if view.consecutive {
+		return 0
+	}
+	return view.first
+}
+
+// Return the last index in the slice.
+//
+// If the slice is empty, the index returned should
+// result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) LastIdx() (idx IDX) {
+	if view.consecutive {
+		return view.last - view.first
+	}
+	return view.last
+}
+
+// Return the next index after the current index in the slice.
+//
+// If the given index is invalid or no next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) NextIdx(thisIdx IDX) (nextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + 1
+	}
+	if thisIdx == view.last {
+		return invalidIdx(view.slice)
+	}
+	return view.slice.NextIdx(thisIdx)
+}
+
+// Return the index `n` places after the current index in the slice.
+//
+// If the given index is invalid or no nth next index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) NthNextIdx(thisIdx IDX, n IDX) (nthNextIdx IDX) {
+	if view.consecutive {
+		return thisIdx + n
+	}
+	if n < 0 {
+		return view.NthPrevIdx(thisIdx, -n)
+	}
+	if !view.slice.IdxValid(thisIdx) || view.slice.LenBetween(thisIdx, view.last) <= n {
+		return invalidIdx(view.slice)
+	}
+	return view.slice.NthNextIdx(thisIdx, n)
+}
+
+// Return the prev index before the current index in the slice.
+//
+// If the given index is invalid or no prev index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) PrevIdx(thisIdx IDX) (prevIdx IDX) {
+	if view.consecutive {
+		return thisIdx - 1
+	}
+	if thisIdx == view.first {
+		return invalidIdx(view.slice)
+	}
+	return view.slice.PrevIdx(thisIdx)
+}
+
+// Return the index `n` places before the current index in the slice.
+//
+// If the given index is invalid or no nth previous index exists,
+// the index returned should result in `IdxValid(idx) == false`
+func (view WindowView[T, IDX]) NthPrevIdx(thisIdx IDX, n IDX) (nthPrevIdx IDX) {
+	if view.consecutive {
+		return thisIdx - n
+	}
+	if n < 0 {
+		return view.NthNextIdx(thisIdx, -n)
+	}
+	if !view.slice.IdxValid(thisIdx) || view.slice.LenBetween(view.first, thisIdx) <= n {
+		return invalidIdx(view.slice)
+	}
+	return view.slice.NthPrevIdx(thisIdx, n)
+}
+
+// Return the current number of values in the slice/list
+//
+// It is not guaranteed that all indexes less than `len` are valid for the slice
+func (view WindowView[T, IDX]) Len() IDX {
+	if view.consecutive {
+		return (view.last - view.first) + 1
+	}
+	return view.slice.LenBetween(view.first, view.last)
+}
+
+// Return the number of items between (and including) `firstIdx` and `lastIdx`
+func (view WindowView[T, IDX]) LenBetween(firstIdx IDX, lastIdx IDX) IDX {
+	if view.consecutive {
+		return (lastIdx - firstIdx) + 1
+	}
+	return view.slice.LenBetween(firstIdx, lastIdx)
+}
+
+// ErrorReporter
+
+// Return the error recorded by the underlying slice, if any
+func (view WindowView[T, IDX]) Err() error {
+	return CheckErr(view.slice)
+}
+
+// Clear any error recorded by the underlying slice
+func (view WindowView[T, IDX]) ResetErr() {
+	ResetErr(view.slice)
+}
+
+func (view WindowView[T, IDX]) storage() (token any, known bool) {
+	return storageOf(view.slice)
+}
+
+var _ SliceLike[byte, int] = WindowView[byte, int]{}
+var _ ErrorReporter = WindowView[byte, int]{}